@@ -0,0 +1,157 @@
+package dcsdk
+
+import (
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	lgv1 "github.com/doublecloud/go-genproto/doublecloud/logs/v1"
+	nwv1 "github.com/doublecloud/go-genproto/doublecloud/network/v1"
+	samlv1 "github.com/doublecloud/go-genproto/doublecloud/organizationmanager/saml/v1"
+	orgv1 "github.com/doublecloud/go-genproto/doublecloud/organizationmanager/v1"
+	trv1 "github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	vzv1 "github.com/doublecloud/go-genproto/doublecloud/visualization/v1"
+	"github.com/doublecloud/go-sdk/gen/clickhouse"
+	"github.com/doublecloud/go-sdk/operation"
+)
+
+//go:generate mockery
+
+// API is a set of DoubleCloud service clients provided by SDK, see SDK.API.
+// Code that depends on API instead of *SDK can be unit-tested with mocks from the mocks package.
+type API interface {
+	ClickHouse() ClickHouseAPI
+	Kafka() KafkaAPI
+	Transfer() TransferAPI
+	Network() NetworkAPI
+	Logs() LogsAPI
+	Visualization() VisualizationAPI
+	Organization() OrganizationAPI
+	// WrapOperation is SDK.WrapOperation.
+	WrapOperation(o *dcv1.Operation, err error) (*operation.Operation, error)
+}
+
+// ClickHouseAPI is a set of ClickHouse service clients.
+type ClickHouseAPI interface {
+	Backup() chv1.BackupServiceClient
+	Cluster() chv1.ClusterServiceClient
+	Operation() chv1.OperationServiceClient
+	Version() chv1.VersionServiceClient
+}
+
+// KafkaAPI is a set of Kafka service clients.
+type KafkaAPI interface {
+	Cluster() kfv1.ClusterServiceClient
+	Operation() kfv1.OperationServiceClient
+	Topic() kfv1.TopicServiceClient
+	User() kfv1.UserServiceClient
+	Version() kfv1.VersionServiceClient
+}
+
+// TransferAPI is a set of Transfer service clients.
+type TransferAPI interface {
+	Endpoint() trv1.EndpointServiceClient
+	Operation() trv1.OperationServiceClient
+	Transfer() trv1.TransferServiceClient
+}
+
+// NetworkAPI is a set of Network service clients.
+type NetworkAPI interface {
+	Network() nwv1.NetworkServiceClient
+	NetworkConnection() nwv1.NetworkConnectionServiceClient
+	Operation() nwv1.OperationServiceClient
+}
+
+// LogsAPI is a set of Logs service clients.
+type LogsAPI interface {
+	Export() lgv1.LogExportServiceClient
+	Operation() lgv1.OperationServiceClient
+}
+
+// VisualizationAPI is a set of Visualization service clients.
+type VisualizationAPI interface {
+	Workbook() vzv1.WorkbookServiceClient
+}
+
+// OrganizationAPI is a set of Organization service clients.
+type OrganizationAPI interface {
+	Group() orgv1.GroupServiceClient
+	GroupMapping() orgv1.GroupMappingServiceClient
+	SamlFederation() samlv1.FederationServiceClient
+}
+
+// API returns service clients of the SDK as interfaces.
+func (sdk *SDK) API() API {
+	return sdkAPI{sdk}
+}
+
+var (
+	_ API            = sdkAPI{}
+	_ clickhouse.API = ClickHouseAPI(nil)
+)
+
+// sdkAPI adapts clients of gen packages to API interfaces, as Go interfaces do not allow
+// methods to return concrete types.
+type sdkAPI struct {
+	sdk *SDK
+}
+
+func (a sdkAPI) ClickHouse() ClickHouseAPI       { return clickHouseAPI{a.sdk} }
+func (a sdkAPI) Kafka() KafkaAPI                 { return kafkaAPI{a.sdk} }
+func (a sdkAPI) Transfer() TransferAPI           { return transferAPI{a.sdk} }
+func (a sdkAPI) Network() NetworkAPI             { return networkAPI{a.sdk} }
+func (a sdkAPI) Logs() LogsAPI                   { return logsAPI{a.sdk} }
+func (a sdkAPI) Visualization() VisualizationAPI { return visualizationAPI{a.sdk} }
+func (a sdkAPI) Organization() OrganizationAPI   { return organizationAPI{a.sdk} }
+func (a sdkAPI) WrapOperation(o *dcv1.Operation, err error) (*operation.Operation, error) {
+	return a.sdk.WrapOperation(o, err)
+}
+
+type clickHouseAPI struct{ sdk *SDK }
+
+func (a clickHouseAPI) Backup() chv1.BackupServiceClient       { return a.sdk.ClickHouse().Backup() }
+func (a clickHouseAPI) Cluster() chv1.ClusterServiceClient     { return a.sdk.ClickHouse().Cluster() }
+func (a clickHouseAPI) Operation() chv1.OperationServiceClient { return a.sdk.ClickHouse().Operation() }
+func (a clickHouseAPI) Version() chv1.VersionServiceClient     { return a.sdk.ClickHouse().Version() }
+
+type kafkaAPI struct{ sdk *SDK }
+
+func (a kafkaAPI) Cluster() kfv1.ClusterServiceClient     { return a.sdk.Kafka().Cluster() }
+func (a kafkaAPI) Operation() kfv1.OperationServiceClient { return a.sdk.Kafka().Operation() }
+func (a kafkaAPI) Topic() kfv1.TopicServiceClient         { return a.sdk.Kafka().Topic() }
+func (a kafkaAPI) User() kfv1.UserServiceClient           { return a.sdk.Kafka().User() }
+func (a kafkaAPI) Version() kfv1.VersionServiceClient     { return a.sdk.Kafka().Version() }
+
+type transferAPI struct{ sdk *SDK }
+
+func (a transferAPI) Endpoint() trv1.EndpointServiceClient   { return a.sdk.Transfer().Endpoint() }
+func (a transferAPI) Operation() trv1.OperationServiceClient { return a.sdk.Transfer().Operation() }
+func (a transferAPI) Transfer() trv1.TransferServiceClient   { return a.sdk.Transfer().Transfer() }
+
+type networkAPI struct{ sdk *SDK }
+
+func (a networkAPI) Network() nwv1.NetworkServiceClient { return a.sdk.Network().Network() }
+func (a networkAPI) NetworkConnection() nwv1.NetworkConnectionServiceClient {
+	return a.sdk.Network().NetworkConnection()
+}
+func (a networkAPI) Operation() nwv1.OperationServiceClient { return a.sdk.Network().Operation() }
+
+type logsAPI struct{ sdk *SDK }
+
+func (a logsAPI) Export() lgv1.LogExportServiceClient    { return a.sdk.Logs().Export() }
+func (a logsAPI) Operation() lgv1.OperationServiceClient { return a.sdk.Logs().Operation() }
+
+type visualizationAPI struct{ sdk *SDK }
+
+func (a visualizationAPI) Workbook() vzv1.WorkbookServiceClient {
+	return a.sdk.Visualization().Workbook()
+}
+
+type organizationAPI struct{ sdk *SDK }
+
+func (a organizationAPI) Group() orgv1.GroupServiceClient { return a.sdk.Organization().Group() }
+func (a organizationAPI) GroupMapping() orgv1.GroupMappingServiceClient {
+	return a.sdk.Organization().GroupMapping()
+}
+func (a organizationAPI) SamlFederation() samlv1.FederationServiceClient {
+	return a.sdk.Organization().SamlFederation()
+}
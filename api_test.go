@@ -0,0 +1,60 @@
+package dcsdk_test
+
+import (
+	"context"
+	"testing"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/dctest"
+	"github.com/doublecloud/go-sdk/mocks"
+	chmocks "github.com/doublecloud/go-sdk/mocks/clickhouse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func clusterNames(ctx context.Context, api dcsdk.API, projectID string) ([]string, error) {
+	resp, err := api.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{ProjectId: projectID})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, c := range resp.Clusters {
+		names = append(names, c.Name)
+	}
+	return names, nil
+}
+
+func TestAPI_Mocks(t *testing.T) {
+	ctx := context.Background()
+	clusters := chmocks.NewClusterServiceClient(t)
+	clusters.EXPECT().
+		List(mock.Anything, &chv1.ListClustersRequest{ProjectId: "p1"}).
+		Return(&chv1.ListClustersResponse{Clusters: []*chv1.Cluster{{Name: "a"}, {Name: "b"}}}, nil)
+	clickHouse := mocks.NewClickHouseAPI(t)
+	clickHouse.EXPECT().Cluster().Return(clusters)
+	api := mocks.NewAPI(t)
+	api.EXPECT().ClickHouse().Return(clickHouse)
+
+	names, err := clusterNames(ctx, api, "p1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestSDK_API(t *testing.T) {
+	ctx := context.Background()
+	srv := dctest.NewServer(dctest.Config{})
+	defer srv.Close()
+	sdk, err := srv.SDK(ctx)
+	require.NoError(t, err)
+	api := sdk.API()
+
+	op, err := api.WrapOperation(api.ClickHouse().Cluster().Create(ctx, &chv1.CreateClusterRequest{ProjectId: "p1", Name: "a"}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+
+	names, err := clusterNames(ctx, api, "p1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, names)
+}
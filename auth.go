@@ -2,7 +2,10 @@ package dcsdk
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"reflect"
 	"sync"
 	"time"
 
@@ -22,18 +25,59 @@ type Authenticator interface {
 
 var _ Authenticator = &SDK{}
 
-func NewIAMTokenMiddleware(authenticator Authenticator, now func() time.Time) *IamTokenMiddleware {
-	return &IamTokenMiddleware{
+// CredentialsAuthenticator is implemented by Authenticator that can issue IAM tokens for arbitrary Credentials.
+// IamTokenMiddleware requires it for calls made WithCredentials.
+type CredentialsAuthenticator interface {
+	CreateIAMTokenForCredentials(ctx context.Context, creds Credentials) (*iamkey.CreateIamTokenResponse, error)
+}
+
+var _ CredentialsAuthenticator = &SDK{}
+
+// TokenInvalidator is implemented by Credentials and Authenticator that cache IAM tokens.
+// IamTokenMiddleware calls it when API rejects the token with UNAUTHENTICATED status,
+// so the rejected token is not used again.
+type TokenInvalidator interface {
+	InvalidateIAMToken(ctx context.Context, iamToken string) error
+}
+
+var _ TokenInvalidator = &SDK{}
+
+type IamTokenMiddlewareOption func(*IamTokenMiddleware)
+
+// withUnauthenticatedMainSubject makes calls authenticated with Authenticator own credentials go without
+// authorization header, e.g. for SDK built with NoCredentials. Calls WithCredentials are still authenticated.
+func withUnauthenticatedMainSubject() IamTokenMiddlewareOption {
+	return func(c *IamTokenMiddleware) {
+		c.unauthenticatedMain = true
+	}
+}
+
+// WithTokenIssuedHook sets hook called after every token issue or refresh, e.g. for auditing.
+func WithTokenIssuedHook(hook TokenIssuedHook) IamTokenMiddlewareOption {
+	return func(c *IamTokenMiddleware) {
+		c.onTokenIssued = hook
+	}
+}
+
+func NewIAMTokenMiddleware(authenticator Authenticator, now func() time.Time, opts ...IamTokenMiddlewareOption) *IamTokenMiddleware {
+	c := &IamTokenMiddleware{
 		now:            now,
 		authenticator:  authenticator,
 		subjectToState: map[authSubject]iamTokenState{},
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 type IamTokenMiddleware struct {
 	authenticator Authenticator
 	// now may be replaced in tests
-	now func() time.Time
+	now           func() time.Time
+	onTokenIssued TokenIssuedHook
+	// unauthenticatedMain is set if Authenticator has no credentials of its own, see withUnauthenticatedMainSubject.
+	unauthenticatedMain bool
 
 	// mutex guards conn and currentState, and excludes multiple simultaneous token updates
 	mutex          sync.RWMutex
@@ -44,6 +88,7 @@ type iamTokenState struct {
 	token     string
 	expiresAt time.Time
 	version   int
+	info      TokenInfo
 }
 
 func WithAuthAsServiceAccount(serviceAccountID string) grpc.CallOption {
@@ -58,35 +103,208 @@ func WithAuthAsServiceAccounts(saGetter SAGetter) grpc.CallOption {
 	return &withServiceAccountID{serviceAccountIDGet: saGetter}
 }
 
+// WithCredentials makes the call authenticated with creds instead of SDK Credentials.
+// Tokens are cached per creds, so a single SDK instance and its connections may act on behalf
+// of many tenants. Reuse the same creds for the same tenant, otherwise a new token is issued on every call.
+// Expired and invalidated tokens are evicted with their creds when a token is issued for other creds,
+// so the cache holds at most one entry per creds with a live token.
+// creds must be comparable, e.g. a pointer, which holds for Credentials returned by this package.
+func WithCredentials(creds Credentials) grpc.CallOption {
+	return &withCredentials{creds: creds}
+}
+
+type withCredentials struct {
+	grpc.EmptyCallOption
+	creds Credentials
+}
+
+// InterceptUnary authenticates unary calls. If API rejects the token with UNAUTHENTICATED status,
+// e.g. because it was revoked before expiration, the token is invalidated and the call is retried once with a fresh one.
 func (c *IamTokenMiddleware) InterceptUnary(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-	ctx, err := c.contextWithAuthMetadata(ctx, method, opts)
+	authCtx, subject, token, err := c.contextWithAuthMetadata(ctx, method, opts)
 	if err != nil {
 		return err
 	}
-	return invoker(ctx, method, req, reply, conn, opts...)
+	err = invoker(authCtx, method, req, reply, conn, opts...)
+	if !c.invalidateIfRejected(ctx, subject, token, err) {
+		return err
+	}
+	authCtx, token, retry := c.reauthenticate(ctx, subject, token)
+	if !retry {
+		return err
+	}
+	err = invoker(authCtx, method, req, reply, conn, opts...)
+	c.invalidateIfRejected(ctx, subject, token, err)
+	return err
 }
 
+// InterceptStream authenticates streaming calls. If API rejects the token with UNAUTHENTICATED status, the token
+// is invalidated and the stream is reopened once with a fresh one. The stream may be rejected on open or, as
+// grpc-go reports server errors, on the first RecvMsg: in the latter case the stream is reopened only if
+// no message has been received yet and the client does not stream, so the request can be sent again.
 func (c *IamTokenMiddleware) InterceptStream(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
-	ctx, err := c.contextWithAuthMetadata(ctx, method, opts)
+	authCtx, subject, token, err := c.contextWithAuthMetadata(ctx, method, opts)
 	if err != nil {
 		return nil, err
 	}
-	return streamer(ctx, desc, conn, method, opts...)
+	open := func(authCtx context.Context) (grpc.ClientStream, error) {
+		return streamer(authCtx, desc, conn, method, opts...)
+	}
+	stream, err := open(authCtx)
+	if c.invalidateIfRejected(ctx, subject, token, err) {
+		authCtx, retryToken, retry := c.reauthenticate(ctx, subject, token)
+		if !retry {
+			return stream, err
+		}
+		token = retryToken
+		stream, err = open(authCtx)
+		c.invalidateIfRejected(ctx, subject, token, err)
+		// The stream has been reopened already, so it is not wrapped.
+		return stream, err
+	}
+	if err != nil || desc.ClientStreams {
+		return stream, err
+	}
+	return &reauthStream{ClientStream: stream, middleware: c, ctx: ctx, subject: subject, token: token, open: open}, nil
+}
+
+// reauthStream reopens server stream with a fresh token if the first RecvMsg fails with UNAUTHENTICATED status.
+// Requests sent before the first RecvMsg are kept to be sent again to the reopened stream.
+type reauthStream struct {
+	grpc.ClientStream
+	middleware *IamTokenMiddleware
+	ctx        context.Context
+	subject    authSubject
+	token      string
+	open       func(authCtx context.Context) (grpc.ClientStream, error)
+
+	// received is set on the first RecvMsg, after that the stream is never reopened.
+	received   bool
+	sent       []interface{}
+	sendClosed bool
+}
+
+func (s *reauthStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if s.received {
+		return err
+	}
+	s.sent = append(s.sent, m)
+	if err == io.EOF {
+		// The stream is closed by the server, its status is returned by RecvMsg, where the stream can be reopened.
+		return nil
+	}
+	return err
+}
+
+func (s *reauthStream) CloseSend() error {
+	s.sendClosed = true
+	return s.ClientStream.CloseSend()
+}
+
+func (s *reauthStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if s.received {
+		return err
+	}
+	s.received = true
+	sent := s.sent
+	s.sent = nil
+	if !s.middleware.invalidateIfRejected(s.ctx, s.subject, s.token, err) {
+		return err
+	}
+	authCtx, token, retry := s.middleware.reauthenticate(s.ctx, s.subject, s.token)
+	if !retry {
+		return err
+	}
+	s.token = token
+	stream, err := s.open(authCtx)
+	if err != nil {
+		s.middleware.invalidateIfRejected(s.ctx, s.subject, token, err)
+		return err
+	}
+	s.ClientStream = stream
+	for _, msg := range sent {
+		if err := stream.SendMsg(msg); err != nil && err != io.EOF {
+			return err
+		}
+	}
+	if s.sendClosed {
+		if err := stream.CloseSend(); err != nil {
+			return err
+		}
+	}
+	err = stream.RecvMsg(m)
+	s.middleware.invalidateIfRejected(s.ctx, s.subject, token, err)
+	return err
+}
+
+// reauthenticate issues a new token after rejectedToken was invalidated. It reports false if the call should not
+// be retried: the token can not be issued, or credentials returned the same rejected token.
+func (c *IamTokenMiddleware) reauthenticate(ctx context.Context, subject authSubject, rejectedToken string) (context.Context, string, bool) {
+	token, err := c.subjectIAMToken(ctx, subject)
+	if err != nil {
+		grpclog.Warningf("IAM Token reissue after rejection failed: %v", err)
+		return nil, "", false
+	}
+	if token == rejectedToken {
+		return nil, "", false
+	}
+	grpclog.Infof("Got new IAM Token, retrying the call.")
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), token, true
 }
 
-func (c *IamTokenMiddleware) contextWithAuthMetadata(ctx context.Context, method string, opts []grpc.CallOption) (context.Context, error) {
+func (c *IamTokenMiddleware) contextWithAuthMetadata(ctx context.Context, method string, opts []grpc.CallOption) (context.Context, authSubject, string, error) {
 	// User can add WithAuthAsServiceAccount to default call options and we will
 	// always try to issue token for service account. That results in a deadlock.
 	// Here we check for methods that always require original authentication and
 	// not delegated mode.
 	needOriginalSubject := false
 	grpclog.Infof("Getting IAM Token for %s", method)
-	token, err := c.GetIAMToken(ctx, needOriginalSubject, opts...)
+	subject, err := callAuthSubject(ctx, needOriginalSubject, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
+	}
+	if _, ok := subject.(mainSubject); ok && c.unauthenticatedMain {
+		grpclog.Infof("No credentials, calling without 'authorization' header.")
+		return ctx, subject, "", nil
+	}
+	token, err := c.subjectIAMToken(ctx, subject)
+	if err != nil {
+		return nil, nil, "", err
 	}
 	grpclog.Infof("Got IAM Token, set 'authorization' header.")
-	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), subject, token, nil
+}
+
+// invalidateIfRejected drops the token from the cache when API responds with UNAUTHENTICATED,
+// so the next call issues a new one instead of reusing the rejected token until it expires.
+// Reports whether the token was rejected, calls without token are never reported.
+func (c *IamTokenMiddleware) invalidateIfRejected(ctx context.Context, subject authSubject, token string, err error) bool {
+	if token == "" || status.Code(err) != codes.Unauthenticated {
+		return false
+	}
+	grpclog.Warningf("IAM Token rejected by API, invalidating: %v", err)
+	c.mutex.Lock()
+	if state, ok := c.subjectToState[subject]; ok && state.token == token {
+		state.expiresAt = time.Time{}
+		c.subjectToState[subject] = state
+	}
+	c.mutex.Unlock()
+
+	var invalidator TokenInvalidator
+	switch subject := subject.(type) {
+	case mainSubject:
+		invalidator, _ = c.authenticator.(TokenInvalidator)
+	case credentialsSubject:
+		invalidator, _ = subject.creds.(TokenInvalidator)
+	}
+	if invalidator != nil {
+		if err := invalidator.InvalidateIAMToken(ctx, token); err != nil {
+			grpclog.Warningf("IAM Token invalidation failed: %v", err)
+		}
+	}
+	return true
 }
 
 func (c *IamTokenMiddleware) GetIAMToken(ctx context.Context, originalSubject bool, opts ...grpc.CallOption) (string, error) {
@@ -94,6 +312,32 @@ func (c *IamTokenMiddleware) GetIAMToken(ctx context.Context, originalSubject bo
 	if err != nil {
 		return "", err
 	}
+	return c.subjectIAMToken(ctx, subject)
+}
+
+// TokenInfo returns info of the token that is used for calls with opts, issuing the token if needed.
+func (c *IamTokenMiddleware) TokenInfo(ctx context.Context, opts ...grpc.CallOption) (*TokenInfo, error) {
+	subject, err := callAuthSubject(ctx, false, opts)
+	if err != nil {
+		return nil, err
+	}
+	token, err := c.subjectIAMToken(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.RLock()
+	state := c.subjectToState[subject]
+	c.mutex.RUnlock()
+	if state.token != token {
+		// Token has been refreshed concurrently, describe the one returned.
+		info := newTokenInfo(token, time.Time{}, subject, c.authenticator)
+		return &info, nil
+	}
+	info := state.info
+	return &info, nil
+}
+
+func (c *IamTokenMiddleware) subjectIAMToken(ctx context.Context, subject authSubject) (string, error) {
 	if subject, ok := subject.(serviceAccountSubject); ok {
 		grpclog.Infof("Getting IAM Token for Service Account: %s. ", subject.serviceAccountID)
 	}
@@ -112,7 +356,7 @@ func (c *IamTokenMiddleware) GetIAMToken(ctx context.Context, originalSubject bo
 	} else {
 		grpclog.Infof("IAM Token expired at: %s. Updating. ", state.expiresAt)
 	}
-	token, err = c.updateToken(ctx, subject, state.version)
+	token, err := c.updateToken(ctx, subject, state.version)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok && st.Code() == codes.Unauthenticated {
@@ -127,7 +371,7 @@ func (c *IamTokenMiddleware) updateToken(ctx context.Context, subject authSubjec
 	c.mutex.RLock()
 	state := c.subjectToState[subject]
 	c.mutex.RUnlock()
-	if state.version != currentVersion {
+	if state.version != currentVersion && state.token != "" {
 		// someone have already updated it
 		return state.token, nil
 	}
@@ -143,16 +387,39 @@ func (c *IamTokenMiddleware) updateToken(ctx context.Context, subject authSubjec
 		expiresAt = c.now().Add(time.Minute)
 	}
 
+	var reportedExpiresAt time.Time
+	if expiresAtErr == nil {
+		reportedExpiresAt = expiresAt
+	}
+	info := newTokenInfo(resp.IamToken, reportedExpiresAt, subject, c.authenticator)
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
+	if _, ok := subject.(credentialsSubject); ok {
+		c.evictExpiredCredentials()
+	}
 	c.subjectToState[subject] = iamTokenState{
 		token:     resp.IamToken,
 		expiresAt: expiresAt,
 		version:   currentVersion + 1,
+		info:      info,
+	}
+	c.mutex.Unlock()
+	if c.onTokenIssued != nil {
+		c.onTokenIssued(ctx, info)
 	}
 	return resp.IamToken, nil
 }
 
+// evictExpiredCredentials drops expired and invalidated tokens of per-call credentials, so credentials
+// of tenants that are not used anymore are not kept forever. c.mutex must be held.
+func (c *IamTokenMiddleware) evictExpiredCredentials() {
+	now := c.now()
+	for subject, state := range c.subjectToState {
+		if _, ok := subject.(credentialsSubject); ok && !state.expiresAt.After(now) {
+			delete(c.subjectToState, subject)
+		}
+	}
+}
+
 type authSubject interface {
 	createIAMToken(ctx context.Context, a Authenticator) (*iamkey.CreateIamTokenResponse, error)
 }
@@ -162,6 +429,7 @@ var _ serviceAccountSubject
 
 type mainSubject struct{}
 type serviceAccountSubject struct{ serviceAccountID string }
+type credentialsSubject struct{ creds Credentials }
 
 func (s mainSubject) createIAMToken(ctx context.Context, a Authenticator) (*iamkey.CreateIamTokenResponse, error) {
 	return a.CreateIAMToken(ctx)
@@ -169,6 +437,13 @@ func (s mainSubject) createIAMToken(ctx context.Context, a Authenticator) (*iamk
 func (s serviceAccountSubject) createIAMToken(ctx context.Context, a Authenticator) (*iamkey.CreateIamTokenResponse, error) {
 	return a.CreateIAMTokenForServiceAccount(ctx, s.serviceAccountID)
 }
+func (s credentialsSubject) createIAMToken(ctx context.Context, a Authenticator) (*iamkey.CreateIamTokenResponse, error) {
+	ca, ok := a.(CredentialsAuthenticator)
+	if !ok {
+		return nil, fmt.Errorf("authenticator %T does not support per-call credentials", a)
+	}
+	return ca.CreateIAMTokenForCredentials(ctx, s.creds)
+}
 
 type withServiceAccountID struct {
 	grpc.EmptyCallOption
@@ -180,13 +455,25 @@ func callAuthSubject(ctx context.Context, originalSubject bool, os []grpc.CallOp
 		return mainSubject{}, nil
 	}
 	var saOpt *withServiceAccountID
+	var credsOpt *withCredentials
 	for _, o := range os {
-		o, ok := o.(*withServiceAccountID)
-		if ok {
+		switch o := o.(type) {
+		case *withServiceAccountID:
 			saOpt = o
+		case *withCredentials:
+			credsOpt = o
 		}
 	}
+	if credsOpt != nil && saOpt != nil {
+		return nil, errors.New("WithCredentials and WithAuthAsServiceAccount can not be used together")
+	}
 	var subject authSubject = mainSubject{}
+	if credsOpt != nil {
+		if credsOpt.creds == nil || !reflect.TypeOf(credsOpt.creds).Comparable() {
+			return nil, fmt.Errorf("credentials %T can not be used per call: not comparable", credsOpt.creds)
+		}
+		subject = credentialsSubject{creds: credsOpt.creds}
+	}
 	if saOpt != nil {
 		sa, err := saOpt.serviceAccountIDGet(ctx)
 		if err != nil {
@@ -2,25 +2,20 @@ package dcsdk
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"github.com/doublecloud/go-sdk/iamkey"
-	"github.com/doublecloud/go-sdk/pkg/browser"
-	"github.com/doublecloud/go-sdk/pkg/server"
 	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
-)
-
-const beforeBrowserOpenNote = `
-You are going to be authenticated via federation-id '%s'.
-Your federation authentication web site will be opened.
-After your successful authentication, you will be redirected to '%s'.
-Press Enter
 
-`
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/doublecloud/go-sdk/pkg/browser"
+	"github.com/doublecloud/go-sdk/pkg/server"
+	"google.golang.org/grpc/grpclog"
+)
 
 func federationURL(federationID, federationEndpoint, federationPath, redirectURL string) (string, error) {
 	baseURL, err := url.Parse(federationEndpoint)
@@ -39,9 +34,6 @@ func federationURL(federationID, federationEndpoint, federationPath, redirectURL
 }
 
 type FederationConfig struct {
-	// Token store temporary IAM token in memory, so browser will not open too often
-	cachedToken *iamkey.CreateIamTokenResponse
-
 	FederationID string
 
 	// Endpoint is an API endpoint of DoubleCloud against which the SDK authorize federated user.
@@ -54,29 +46,138 @@ type FederationConfig struct {
 	FederationPath string
 	// TokenCachePath where to store IAM token between SDK-calls
 	// Default value: ~/.dcsdk
-	TokenCachePath    string
+	TokenCachePath string
+	// DisableTokenCache keeps IAM token in memory only.
 	DisableTokenCache bool
+	// TokenCache overrides where IAM token is stored between SDK-calls, e.g. KeyringTokenCache.
+	// By default FileTokenCache in TokenCachePath is used.
+	TokenCache TokenCache
+
+	// Mode defines how federation login page is opened, see FederationLoginMode.
+	// Default value: FederationLoginBrowser
+	Mode FederationLoginMode
+	// CallbackAddr is an address of the local server that receives token after authentication.
+	// Default value: random port on loopback interface
+	CallbackAddr string
+	// Prompt renders login steps to the user.
+	// Default value: console prompt on stdin and stdout
+	Prompt FederationPrompt
+	// SuccessHTML is a page shown in browser after successful authentication.
+	// By default browser is redirected to DoubleCloud console.
+	SuccessHTML string
+	// InsecureSkipState makes callbacks accepted without the state bound to the login,
+	// so any local process may complete the login with its own token. Use it only if federation
+	// does not redirect to the exact redirect URL it is given.
+	InsecureSkipState bool
 }
 
 type FederationCredentials struct {
-	cfg *FederationConfig
+	cfg   *FederationConfig
+	cache TokenCache
+
+	// mu guards token and excludes simultaneous authentications
+	mu    sync.Mutex
+	token *iamkey.CreateIamTokenResponse
+}
+
+var _ NonExchangeableCredentials = &FederationCredentials{}
+var _ TokenInvalidator = &FederationCredentials{}
+
+func (ft *FederationCredentials) loginMode() FederationLoginMode {
+	if ft.cfg.Mode == FederationLoginBrowser && !browser.OpenURLSupported() {
+		return FederationLoginPaste
+	}
+	return ft.cfg.Mode
+}
+
+func (ft *FederationCredentials) login(ctx context.Context) (*server.Token, error) {
+	consoleURL, err := ft.consoleURL()
+	if err != nil {
+		return nil, err
+	}
+	mode := ft.loginMode()
+	if mode == FederationLoginPaste {
+		return ft.pasteLogin(ctx, consoleURL)
+	}
+
+	urlRequest := func(redirectURL string) error {
+		requestURL, err := federationURL(
+			ft.cfg.FederationID,
+			ft.cfg.FederationEndpoint,
+			ft.cfg.FederationPath,
+			redirectURL)
+		if err != nil {
+			return err
+		}
+		login := FederationLogin{
+			FederationID: ft.cfg.FederationID,
+			Mode:         mode,
+			LoginURL:     requestURL,
+			RedirectURL:  redirectURL,
+			ConsoleURL:   consoleURL,
+		}
+		if err := ft.cfg.Prompt.ShowLogin(ctx, login); err != nil {
+			return err
+		}
+		if mode == FederationLoginBrowser {
+			return browser.OpenURL(requestURL)
+		}
+		return nil
+	}
+	opts := []server.Option{server.WithAddr(ft.cfg.CallbackAddr), server.WithConsoleURL(consoleURL)}
+	if ft.cfg.SuccessHTML != "" {
+		opts = append(opts, server.WithSuccessHTML(ft.cfg.SuccessHTML))
+	}
+	if ft.cfg.InsecureSkipState {
+		opts = append(opts, server.WithoutState())
+	}
+	return server.Fetch(ctx, urlRequest, opts...)
 }
 
-func (ft *FederationCredentials) urlRequest(serverAddr string) error {
+func (ft *FederationCredentials) pasteLogin(ctx context.Context, consoleURL string) (*server.Token, error) {
+	redirectURL := pasteRedirectURL
+	var state string
+	if !ft.cfg.InsecureSkipState {
+		var err error
+		if state, err = server.NewState(); err != nil {
+			return nil, err
+		}
+		redirectURL += server.StatePath("", state)
+	}
 	requestURL, err := federationURL(
 		ft.cfg.FederationID,
 		ft.cfg.FederationEndpoint,
 		ft.cfg.FederationPath,
-		fmt.Sprintf("http://%s", serverAddr))
+		redirectURL)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	if err := browser.OpenURL(requestURL); err != nil {
-		return err
+	err = ft.cfg.Prompt.ShowLogin(ctx, FederationLogin{
+		FederationID: ft.cfg.FederationID,
+		Mode:         FederationLoginPaste,
+		LoginURL:     requestURL,
+		RedirectURL:  redirectURL,
+		ConsoleURL:   consoleURL,
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
+	pasted, err := ft.cfg.Prompt.ReadRedirectURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	redirected, err := url.Parse(pasted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redirect URL: %v", err)
+	}
+	if !ft.cfg.InsecureSkipState && !server.CheckState(redirected, state) {
+		return nil, server.ErrInvalidState
+	}
+	token := server.ParseToken(redirected.Query())
+	if token.Err != nil {
+		return nil, token.Err
+	}
+	return token, nil
 }
 
 func (ft *FederationCredentials) consoleURL() (string, error) {
@@ -91,59 +192,68 @@ func (ft *FederationCredentials) consoleURL() (string, error) {
 func (ft *FederationCredentials) DCAPICredentials() {}
 
 func (ft *FederationCredentials) IAMToken(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
-	oldToken := ft.cfg.cachedToken
-	if oldToken != nil && oldToken.ExpiresAt.AsTime().After(time.Now()) {
-		return &iamkey.CreateIamTokenResponse{
-			IamToken:  oldToken.IamToken,
-			ExpiresAt: oldToken.ExpiresAt,
-		}, nil
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+
+	if validToken(ft.token) {
+		return copyToken(ft.token), nil
 	}
 
-	consoleURL, err := ft.consoleURL()
+	if locker, ok := ft.cache.(TokenCacheLocker); ok {
+		unlock, err := locker.Lock(ctx, ft.cfg.FederationID)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = unlock() }()
+	}
+	// Token may be refreshed by another process while we were waiting for the lock.
+	cached, err := ft.cache.Get(ctx, ft.cfg.FederationID)
 	if err != nil {
-		return nil, err
+		grpclog.Warningf("Federation token cache read failed: %v", err)
+	}
+	if validToken(cached) {
+		ft.token = cached
+		return copyToken(ft.token), nil
 	}
-	fmt.Printf(beforeBrowserOpenNote, ft.cfg.FederationID, consoleURL)
-	token, err := server.GetToken(ctx, ft.urlRequest, consoleURL)
+
+	token, err := ft.login(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	ft.cfg.cachedToken = &iamkey.CreateIamTokenResponse{
+	ft.token = &iamkey.CreateIamTokenResponse{
 		IamToken:  token.IamToken,
 		ExpiresAt: token.ExpiresAt,
 	}
-	tokenData, err := json.Marshal(ft.cfg.cachedToken)
-	if err != nil {
+	if err := ft.cache.Set(ctx, ft.cfg.FederationID, ft.token); err != nil {
 		return nil, err
 	}
 
-	if err := os.MkdirAll(
-		ft.cfg.TokenCachePath,
-		0770,
-	); err != nil && !os.IsExist(err) {
-		return nil, err
-	}
+	ft.cfg.Prompt.ShowSuccess(ctx, token.ExpiresAt.AsTime())
+	return copyToken(ft.token), nil
+}
 
-	if err := os.WriteFile(
-		fmt.Sprintf(
-			"%s/%s.json",
-			ft.cfg.TokenCachePath,
-			ft.cfg.FederationID,
-		),
-		tokenData,
-		0660,
-	); err != nil {
-		return nil, err
+// InvalidateIAMToken drops the token from memory and cache, if it is still the current one,
+// so the next IAMToken call authenticates again.
+func (ft *FederationCredentials) InvalidateIAMToken(ctx context.Context, iamToken string) error {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+	if ft.token == nil || ft.token.IamToken != iamToken {
+		return nil
 	}
+	ft.token = nil
+	return ft.cache.Delete(ctx, ft.cfg.FederationID)
+}
+
+func validToken(token *iamkey.CreateIamTokenResponse) bool {
+	return token != nil && token.GetIamToken() != "" && token.GetExpiresAt().AsTime().After(time.Now())
+}
 
-	fmt.Printf("Federation successfully finished, token will expire at %s\n", token.ExpiresAt.AsTime())
-	iamToken := &iamkey.CreateIamTokenResponse{
+func copyToken(token *iamkey.CreateIamTokenResponse) *iamkey.CreateIamTokenResponse {
+	return &iamkey.CreateIamTokenResponse{
 		IamToken:  token.IamToken,
 		ExpiresAt: token.ExpiresAt,
 	}
-
-	return iamToken, nil
 }
 
 func NewFederationCredentials(cfg *FederationConfig) NonExchangeableCredentials {
@@ -155,23 +265,22 @@ func NewFederationCredentials(cfg *FederationConfig) NonExchangeableCredentials
 	}
 	if cfg.TokenCachePath == "" {
 		home, _ := os.UserHomeDir()
-		cfg.TokenCachePath = fmt.Sprintf("%s/%s.dcsdk", home, cleanEndpoint(cfg.FederationEndpoint))
+		cfg.TokenCachePath = filepath.Join(home, cleanEndpoint(cfg.FederationEndpoint)+".dcsdk")
 	}
-	if !cfg.DisableTokenCache {
-		cachedToken, err := os.ReadFile(fmt.Sprintf("%s/%s.json", cfg.TokenCachePath, cfg.FederationID))
-		if err != nil {
-			return &FederationCredentials{
-				cfg: cfg,
-			}
-		}
-		if err := json.Unmarshal(cachedToken, &cfg.cachedToken); err != nil {
-			return &FederationCredentials{
-				cfg: cfg,
-			}
+	if cfg.Prompt == nil {
+		cfg.Prompt = NewConsoleFederationPrompt(nil, nil)
+	}
+	cache := cfg.TokenCache
+	if cache == nil {
+		if cfg.DisableTokenCache {
+			cache = NewMemoryTokenCache()
+		} else {
+			cache = NewFileTokenCache(cfg.TokenCachePath)
 		}
 	}
 	return &FederationCredentials{
-		cfg: cfg,
+		cfg:   cfg,
+		cache: cache,
 	}
 }
 
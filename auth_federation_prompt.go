@@ -0,0 +1,144 @@
+package dcsdk
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// FederationLoginMode defines how federation login page is opened and how token is passed back to the SDK.
+type FederationLoginMode int
+
+const (
+	// FederationLoginBrowser opens federation login page in browser and waits for redirect to local callback server.
+	// Falls back to FederationLoginPaste if browser can not be opened on this machine.
+	FederationLoginBrowser FederationLoginMode = iota
+	// FederationLoginCallback prints federation login URL and waits for redirect to local callback server.
+	// Set FederationConfig.CallbackAddr to a fixed address in order to forward it from the machine with browser,
+	// e.g. with ssh -L 8085:127.0.0.1:8085.
+	FederationLoginCallback
+	// FederationLoginPaste prints federation login URL and reads back the URL browser was redirected to
+	// after successful authentication. Works without any network access to the machine running the SDK.
+	FederationLoginPaste
+)
+
+// pasteRedirectURL is a redirect URL for FederationLoginPaste mode. Nothing listens on it,
+// user copies the URL with token from the browser address bar.
+const pasteRedirectURL = "http://127.0.0.1/"
+
+// FederationLogin describes federation login step to show to the user.
+type FederationLogin struct {
+	FederationID string
+	Mode         FederationLoginMode
+	// LoginURL is a federation login page. In FederationLoginBrowser mode it's already opened in browser.
+	LoginURL string
+	// RedirectURL is where browser is redirected with token after successful authentication.
+	RedirectURL string
+	// ConsoleURL is a page the local callback server redirects browser to after receiving token.
+	ConsoleURL string
+}
+
+// FederationPrompt renders federation login steps to the user. GUI tools can implement it
+// to show login steps in their own UI.
+type FederationPrompt interface {
+	// ShowLogin is called before waiting for the user to authenticate.
+	ShowLogin(ctx context.Context, login FederationLogin) error
+	// ReadRedirectURL returns the URL browser was redirected to after authentication.
+	// It is called in FederationLoginPaste mode only.
+	ReadRedirectURL(ctx context.Context) (string, error)
+	// ShowSuccess is called after successful authentication.
+	ShowSuccess(ctx context.Context, expiresAt time.Time)
+}
+
+// NewConsoleFederationPrompt creates FederationPrompt that writes messages to out and reads pasted URL from in.
+// Stdin and stdout are used for nil in and out.
+func NewConsoleFederationPrompt(in io.Reader, out io.Writer) FederationPrompt {
+	if in == nil {
+		in = os.Stdin
+	}
+	if out == nil {
+		out = os.Stdout
+	}
+	return &consoleFederationPrompt{in: bufio.NewReader(in), out: out}
+}
+
+type consoleFederationPrompt struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+const beforeBrowserOpenNote = `
+You are going to be authenticated via federation-id '%s'.
+Your federation authentication web site will be opened.
+After your successful authentication, you will be redirected to '%s'.
+
+`
+
+const beforeCallbackNote = `
+You are going to be authenticated via federation-id '%s'.
+Open the following link in your browser:
+
+	%s
+
+After your successful authentication, you will be redirected to '%s'.
+Make sure this address is reachable from your browser, e.g. forward it with ssh -L.
+
+`
+
+const beforePasteNote = `
+You are going to be authenticated via federation-id '%s'.
+Open the following link in your browser:
+
+	%s
+
+After your successful authentication, browser will be redirected to '%s' and fail to load the page.
+Copy the full URL from the browser address bar and paste it here.
+
+`
+
+func (p *consoleFederationPrompt) ShowLogin(ctx context.Context, login FederationLogin) error {
+	var err error
+	switch login.Mode {
+	case FederationLoginCallback:
+		_, err = fmt.Fprintf(p.out, beforeCallbackNote, login.FederationID, login.LoginURL, login.RedirectURL)
+	case FederationLoginPaste:
+		_, err = fmt.Fprintf(p.out, beforePasteNote, login.FederationID, login.LoginURL, login.RedirectURL)
+	default:
+		_, err = fmt.Fprintf(p.out, beforeBrowserOpenNote, login.FederationID, login.ConsoleURL)
+	}
+	return err
+}
+
+func (p *consoleFederationPrompt) ReadRedirectURL(ctx context.Context) (string, error) {
+	if _, err := fmt.Fprint(p.out, "Redirect URL: "); err != nil {
+		return "", err
+	}
+	type result struct {
+		line string
+		err  error
+	}
+	// Reading is not interruptible, so a pending read is abandoned when ctx is done.
+	read := make(chan result, 1)
+	go func() {
+		line, err := p.in.ReadString('\n')
+		if errors.Is(err, io.EOF) && line != "" {
+			err = nil
+		}
+		read <- result{strings.TrimSpace(line), err}
+	}()
+	select {
+	case r := <-read:
+		return r.line, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (p *consoleFederationPrompt) ShowSuccess(ctx context.Context, expiresAt time.Time) {
+	_, _ = fmt.Fprintf(p.out, "Federation successfully finished, token will expire at %s\n", expiresAt)
+}
@@ -0,0 +1,80 @@
+package dcsdk
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pastePrompt pastes back the redirect URL with query appended.
+type pastePrompt struct {
+	query url.Values
+	login FederationLogin
+	// forged replaces redirect URL shown in ShowLogin, if set.
+	forged string
+}
+
+func (p *pastePrompt) ShowLogin(ctx context.Context, login FederationLogin) error {
+	p.login = login
+	return nil
+}
+
+func (p *pastePrompt) ReadRedirectURL(ctx context.Context) (string, error) {
+	if p.forged != "" {
+		return p.forged + "?" + p.query.Encode(), nil
+	}
+	u, err := url.Parse(p.login.RedirectURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	for k, v := range p.query {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func (p *pastePrompt) ShowSuccess(ctx context.Context, expiresAt time.Time) {}
+
+func TestFederationCredentials_PasteLogin(t *testing.T) {
+	for _, skipState := range []bool{false, true} {
+		expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		prompt := &pastePrompt{query: url.Values{
+			"token":     {"iam-token"},
+			"expiresAt": {expiresAt.Format(time.RFC3339)},
+		}}
+		creds := NewFederationCredentials(&FederationConfig{
+			FederationID:      "fed",
+			Mode:              FederationLoginPaste,
+			DisableTokenCache: true,
+			Prompt:            prompt,
+			InsecureSkipState: skipState,
+		})
+		token, err := creds.IAMToken(context.Background())
+		require.NoError(t, err, skipState)
+		assert.Equal(t, "iam-token", token.IamToken)
+		assert.Equal(t, expiresAt, token.ExpiresAt.AsTime())
+		assert.Contains(t, prompt.login.LoginURL, "https://auth.double.cloud/federations/fed?redirectUrl=")
+		assert.Equal(t, skipState, prompt.login.RedirectURL == pasteRedirectURL, skipState)
+	}
+}
+
+func TestFederationCredentials_PasteLoginInvalidState(t *testing.T) {
+	prompt := &pastePrompt{forged: pasteRedirectURL + "forged", query: url.Values{
+		"token":     {"iam-token"},
+		"expiresAt": {time.Now().Add(time.Hour).Format(time.RFC3339)},
+	}}
+	creds := NewFederationCredentials(&FederationConfig{
+		FederationID:      "fed",
+		Mode:              FederationLoginPaste,
+		DisableTokenCache: true,
+		Prompt:            prompt,
+	})
+	_, err := creds.IAMToken(context.Background())
+	assert.Error(t, err)
+}
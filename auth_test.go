@@ -0,0 +1,293 @@
+package dcsdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// countingAuthenticator issues token "main" for SDK credentials or IAM token of per-call credentials, and counts issues.
+type countingAuthenticator struct {
+	mu     sync.Mutex
+	issued map[Credentials]int
+}
+
+func newCountingAuthenticator() *countingAuthenticator {
+	return &countingAuthenticator{issued: map[Credentials]int{}}
+}
+
+func (a *countingAuthenticator) CreateIAMToken(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
+	return a.CreateIAMTokenForCredentials(ctx, nil)
+}
+
+func (a *countingAuthenticator) CreateIAMTokenForServiceAccount(ctx context.Context, serviceAccountID string) (*iamkey.CreateIamTokenResponse, error) {
+	return nil, nil
+}
+
+func (a *countingAuthenticator) CreateIAMTokenForCredentials(ctx context.Context, creds Credentials) (*iamkey.CreateIamTokenResponse, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.issued[creds]++
+	token := "main"
+	if creds != nil {
+		resp, err := creds.(NonExchangeableCredentials).IAMToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		token = resp.IamToken
+	}
+	return &iamkey.CreateIamTokenResponse{
+		IamToken:  token,
+		ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+	}, nil
+}
+
+func (a *countingAuthenticator) count(creds Credentials) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.issued[creds]
+}
+
+func TestIamTokenMiddleware_WithCredentials(t *testing.T) {
+	ctx := context.Background()
+	auth := newCountingAuthenticator()
+	m := NewIAMTokenMiddleware(auth, time.Now)
+	tenantA := NewIAMTokenCredentials("tenant-a")
+	tenantB := NewIAMTokenCredentials("tenant-b")
+
+	for i := 0; i < 2; i++ {
+		token, err := m.GetIAMToken(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, "main", token)
+
+		token, err = m.GetIAMToken(ctx, false, WithCredentials(tenantA))
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-a", token)
+
+		token, err = m.GetIAMToken(ctx, false, WithCredentials(tenantB))
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-b", token)
+	}
+	assert.Equal(t, 1, auth.count(nil))
+	assert.Equal(t, 1, auth.count(tenantA))
+	assert.Equal(t, 1, auth.count(tenantB))
+
+	// Original subject ignores per-call credentials.
+	token, err := m.GetIAMToken(ctx, true, WithCredentials(tenantA))
+	require.NoError(t, err)
+	assert.Equal(t, "main", token)
+}
+
+func TestIamTokenMiddleware_WithCredentialsEviction(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := NewIAMTokenMiddleware(newCountingAuthenticator(), func() time.Time { return now })
+	tenantA := NewIAMTokenCredentials("tenant-a")
+	tenantB := NewIAMTokenCredentials("tenant-b")
+
+	_, err := m.GetIAMToken(ctx, false)
+	require.NoError(t, err)
+	_, err = m.GetIAMToken(ctx, false, WithCredentials(tenantA))
+	require.NoError(t, err)
+	assert.Len(t, m.subjectToState, 2)
+
+	now = now.Add(2 * time.Hour)
+	token, err := m.GetIAMToken(ctx, false, WithCredentials(tenantB))
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-b", token)
+	assert.NotContains(t, m.subjectToState, credentialsSubject{creds: tenantA}, "expired credentials must be evicted")
+	assert.Contains(t, m.subjectToState, authSubject(mainSubject{}))
+	assert.Len(t, m.subjectToState, 2)
+
+	token, err = m.GetIAMToken(ctx, false, WithCredentials(tenantA))
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", token)
+}
+
+type funcCredentials func()
+
+func (funcCredentials) DCAPICredentials() {}
+
+func TestIamTokenMiddleware_WithCredentialsInvalid(t *testing.T) {
+	ctx := context.Background()
+	m := NewIAMTokenMiddleware(newCountingAuthenticator(), time.Now)
+
+	_, err := m.GetIAMToken(ctx, false, WithCredentials(funcCredentials(func() {})))
+	assert.Error(t, err)
+
+	_, err = m.GetIAMToken(ctx, false, WithCredentials(NoCredentials{}), WithAuthAsServiceAccount("sa"))
+	assert.Error(t, err)
+}
+
+func TestIamTokenMiddleware_TokenInfo(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	jwtToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "user-1",
+		"iss": "https://auth.double.cloud",
+		"aud": "api",
+		"exp": expiresAt.Unix(),
+		"org": "org-1",
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	var issued []TokenInfo
+	hook := func(ctx context.Context, info TokenInfo) { issued = append(issued, info) }
+	m := NewIAMTokenMiddleware(newCountingAuthenticator(), time.Now, WithTokenIssuedHook(hook))
+	tenant := NewIAMTokenCredentials(jwtToken)
+
+	info, err := m.TokenInfo(ctx, WithCredentials(tenant))
+	require.NoError(t, err)
+	assert.True(t, info.JWT)
+	assert.Equal(t, "user-1", info.Subject)
+	assert.Equal(t, "https://auth.double.cloud", info.Issuer)
+	assert.Equal(t, []string{"api"}, info.Audience)
+	assert.Equal(t, "org-1", info.Claims["org"])
+	assert.Equal(t, "*dcsdk.IAMTokenCredentials", info.CredentialsType)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), info.ExpiresAt, 5*time.Second)
+
+	info, err = m.TokenInfo(ctx)
+	require.NoError(t, err)
+	assert.False(t, info.JWT)
+
+	_, err = m.TokenInfo(ctx, WithCredentials(tenant))
+	require.NoError(t, err)
+	require.Len(t, issued, 2, "hook must be called on issue only")
+	assert.Equal(t, "user-1", issued[0].Subject)
+}
+
+// sequenceAuthenticator issues a new token "token-<n>" on every call.
+type sequenceAuthenticator struct {
+	issued int32
+}
+
+func (a *sequenceAuthenticator) CreateIAMToken(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
+	n := atomic.AddInt32(&a.issued, 1)
+	return &iamkey.CreateIamTokenResponse{
+		IamToken:  fmt.Sprintf("token-%d", n),
+		ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+	}, nil
+}
+
+func (a *sequenceAuthenticator) CreateIAMTokenForServiceAccount(ctx context.Context, serviceAccountID string) (*iamkey.CreateIamTokenResponse, error) {
+	return nil, nil
+}
+
+// rejectingInvoker fails calls authorized with rejected tokens with UNAUTHENTICATED status.
+func rejectingInvoker(calls *[]string, rejected ...string) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		auth := md.Get("authorization")
+		token := strings.TrimPrefix(auth[len(auth)-1], "Bearer ")
+		*calls = append(*calls, token)
+		for _, r := range rejected {
+			if token == r {
+				return status.Error(codes.Unauthenticated, "token revoked")
+			}
+		}
+		return nil
+	}
+}
+
+func TestIamTokenMiddleware_RetryOnUnauthenticated(t *testing.T) {
+	ctx := context.Background()
+	auth := &sequenceAuthenticator{}
+	m := NewIAMTokenMiddleware(auth, time.Now)
+
+	var calls []string
+	err := m.InterceptUnary(ctx, "/test/Method", nil, nil, nil, rejectingInvoker(&calls, "token-1"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token-1", "token-2"}, calls)
+
+	calls = nil
+	err = m.InterceptUnary(ctx, "/test/Method", nil, nil, nil, rejectingInvoker(&calls, "token-2", "token-3"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, []string{"token-2", "token-3"}, calls, "call must be retried once only")
+
+	calls = nil
+	err = m.InterceptUnary(ctx, "/test/Method", nil, nil, nil, rejectingInvoker(&calls))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token-4"}, calls, "token rejected on retry must be invalidated too")
+}
+
+func TestIamTokenMiddleware_NoRetryWithSameToken(t *testing.T) {
+	ctx := context.Background()
+	m := NewIAMTokenMiddleware(newCountingAuthenticator(), time.Now)
+
+	var calls []string
+	err := m.InterceptUnary(ctx, "/test/Method", nil, nil, nil, rejectingInvoker(&calls, "main"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, []string{"main"}, calls)
+}
+
+// rejectingHealthServer serves health service over bufconn and fails streams authorized with rejected tokens
+// with UNAUTHENTICATED status. grpc-go reports such failure to the client on the first RecvMsg.
+func rejectingHealthServer(t *testing.T, m *IamTokenMiddleware, calls *[]string, rejected ...string) healthpb.HealthClient {
+	var mu sync.Mutex
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		token := strings.TrimPrefix(md.Get("authorization")[0], "Bearer ")
+		mu.Lock()
+		*calls = append(*calls, token)
+		mu.Unlock()
+		for _, r := range rejected {
+			if token == r {
+				return status.Error(codes.Unauthenticated, "token revoked")
+			}
+		}
+		return handler(srv, ss)
+	}))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStreamInterceptor(m.InterceptStream),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return healthpb.NewHealthClient(conn)
+}
+
+func TestIamTokenMiddleware_StreamRetryOnUnauthenticated(t *testing.T) {
+	ctx := context.Background()
+	m := NewIAMTokenMiddleware(&sequenceAuthenticator{}, time.Now)
+
+	var calls []string
+	client := rejectingHealthServer(t, m, &calls, "token-1", "token-2", "token-3")
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, []string{"token-1", "token-2"}, calls, "stream must be reopened once only")
+
+	calls = nil
+	stream, err = client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	assert.Equal(t, []string{"token-3", "token-4"}, calls, "token rejected on reopen must be invalidated too")
+}
@@ -3,6 +3,7 @@ package dcsdk
 import (
 	"context"
 	"crypto"
+	"crypto/rand"
 	"crypto/rsa"
 	"errors"
 	"fmt" //nolint:staticcheck
@@ -49,17 +50,18 @@ func ServiceAccountKey(key *iamkey.Key) (Credentials, error) {
 	if err != nil {
 		return nil, err
 	}
-	return exchangeableCredentialsFunc(func() (*iamkey.CreateIamTokenRequest, error) {
-		signedJWT, err := jwtBuilder.SignedToken()
-		if err != nil {
-			return nil, sdkerrors.WithMessage(err, "JWT sign failed")
-		}
-		return &iamkey.CreateIamTokenRequest{
-			Identity: &iamkey.CreateIamTokenRequest_Jwt{
-				Jwt: signedJWT,
-			},
-		}, nil
-	}), nil
+	return jwtBuilder.credentials(), nil
+}
+
+// ServiceAccountSigner returns credentials that sign JWT tokens for the service account key keyID
+// with signer, e.g. a key held by KMS, HSM or a local signing agent, so the private key never
+// has to be loaded into the process memory. Signer must hold RSA private key, tokens are signed with PS256.
+func ServiceAccountSigner(keyID, serviceAccountID string, signer crypto.Signer) (Credentials, error) {
+	jwtBuilder, err := newSignerJWTBuilder(keyID, serviceAccountID, signer)
+	if err != nil {
+		return nil, err
+	}
+	return jwtBuilder.credentials(), nil
 }
 
 func newServiceAccountJWTBuilder(key *iamkey.Key) (*serviceAccountJWTBuilder, error) {
@@ -72,8 +74,30 @@ func newServiceAccountJWTBuilder(key *iamkey.Key) (*serviceAccountJWTBuilder, er
 		return nil, sdkerrors.WithMessage(err, "private key parsing failed")
 	}
 	return &serviceAccountJWTBuilder{
-		key:           key,
-		rsaPrivateKey: rsaPrivateKey,
+		key:              key,
+		keyID:            key.Id,
+		serviceAccountID: key.GetServiceAccountId(),
+		signer:           rsaPrivateKey,
+	}, nil
+}
+
+func newSignerJWTBuilder(keyID, serviceAccountID string, signer crypto.Signer) (*serviceAccountJWTBuilder, error) {
+	if keyID == "" {
+		return nil, errors.New("key id is missing")
+	}
+	if serviceAccountID == "" {
+		return nil, errors.New("service account id is missing")
+	}
+	if signer == nil {
+		return nil, errors.New("signer is missing")
+	}
+	if _, ok := signer.Public().(*rsa.PublicKey); !ok {
+		return nil, fmt.Errorf("signer should hold RSA key, but public key is %T", signer.Public())
+	}
+	return &serviceAccountJWTBuilder{
+		keyID:            keyID,
+		serviceAccountID: serviceAccountID,
+		signer:           signer,
 	}, nil
 }
 
@@ -88,45 +112,78 @@ func validateServiceAccountKey(key *iamkey.Key) error {
 }
 
 type serviceAccountJWTBuilder struct {
-	key           *iamkey.Key
-	rsaPrivateKey *rsa.PrivateKey
+	// key is set for builders created from iamkey.Key only.
+	key              *iamkey.Key
+	keyID            string
+	serviceAccountID string
+	signer           crypto.Signer
 }
 
 func (b *serviceAccountJWTBuilder) SignedToken() (string, error) {
-	return b.issueToken().SignedString(b.rsaPrivateKey)
+	return b.issueToken().SignedString(b.signer)
+}
+
+// credentials returns the builder itself: unlike a func, a pointer can be compared,
+// so the credentials can be used as a key of per-credentials token cache, see WithCredentials.
+func (b *serviceAccountJWTBuilder) credentials() ExchangeableCredentials {
+	return b
+}
+
+func (b *serviceAccountJWTBuilder) DCAPICredentials() {}
+
+func (b *serviceAccountJWTBuilder) IAMTokenRequest() (*iamkey.CreateIamTokenRequest, error) {
+	signedJWT, err := b.SignedToken()
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "JWT sign failed")
+	}
+	return &iamkey.CreateIamTokenRequest{
+		Identity: &iamkey.CreateIamTokenRequest_Jwt{
+			Jwt: signedJWT,
+		},
+	}, nil
 }
 
 func (b *serviceAccountJWTBuilder) issueToken() *jwt.Token {
 	issuedAt := time.Now()
 	token := jwt.NewWithClaims(jwtSigningMethodPS256WithSaltLengthEqualsHash, jwt.RegisteredClaims{
-		Issuer:    b.key.GetServiceAccountId(),
-		Subject:   b.key.GetServiceAccountId(),
+		Issuer:    b.serviceAccountID,
+		Subject:   b.serviceAccountID,
 		IssuedAt:  jwt.NewNumericDate(issuedAt),
 		ExpiresAt: jwt.NewNumericDate(issuedAt.Add(time.Hour)),
 		Audience:  jwt.ClaimStrings{tokenURL()},
 	})
-	token.Header["kid"] = b.key.Id
+	token.Header["kid"] = b.keyID
 	return token
 }
 
+// signerSigningMethod is PS256 that signs with crypto.Signer instead of *rsa.PrivateKey.
+// Verification is delegated to the wrapped method.
+type signerSigningMethod struct {
+	*jwt.SigningMethodRSAPSS
+}
+
+func (m signerSigningMethod) Sign(signingString string, key interface{}) (string, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+	hasher := m.Hash.New()
+	hasher.Write([]byte(signingString))
+	sig, err := signer.Sign(rand.Reader, hasher.Sum(nil), m.Options)
+	if err != nil {
+		return "", err
+	}
+	return jwt.EncodeSegment(sig), nil
+}
+
 // Should be removed after https://github.com/dgrijalva/jwt-go/issues/285 fix.
-var jwtSigningMethodPS256WithSaltLengthEqualsHash = &jwt.SigningMethodRSAPSS{
+var jwtSigningMethodPS256WithSaltLengthEqualsHash = signerSigningMethod{&jwt.SigningMethodRSAPSS{
 	SigningMethodRSA: jwt.SigningMethodPS256.SigningMethodRSA,
 	Options: &rsa.PSSOptions{
 		Hash:       crypto.SHA256,
 		SaltLength: rsa.PSSSaltLengthEqualsHash,
 	},
-}
-
-type exchangeableCredentialsFunc func() (iamTokenReq *iamkey.CreateIamTokenRequest, err error)
-
-var _ ExchangeableCredentials = (exchangeableCredentialsFunc)(nil)
-
-func (exchangeableCredentialsFunc) DCAPICredentials() {}
-
-func (f exchangeableCredentialsFunc) IAMTokenRequest() (iamTokenReq *iamkey.CreateIamTokenRequest, err error) {
-	return f()
-}
+}}
 
 // NoCredentials implements Credentials, it allows to create unauthenticated connections
 type NoCredentials struct{}
@@ -0,0 +1,114 @@
+package dcsdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultExecTimeout = time.Minute
+	// execTokenExpirySkew is how long before expires_at cached token is considered expired.
+	execTokenExpirySkew = time.Minute
+	maxExecStderrLen    = 1024
+)
+
+// ExecConfig configures ExecCredentials.
+type ExecConfig struct {
+	// Command is an executable that prints IAM token to stdout.
+	Command string
+	Args    []string
+	// Env is appended to the environment of the current process.
+	Env []string
+	// Timeout limits a single command run.
+	// Default value: DefaultExecTimeout
+	Timeout time.Duration
+}
+
+// ExecCredentials get IAM Token from an external command, like kubectl exec credential plugins do.
+// The command must print JSON object to stdout with IAM token and expiration time in RFC3339 format:
+//
+//	{"iam_token": "...", "expires_at": "2024-01-02T03:04:05Z"}
+//
+// camelCase field names are accepted too. Token is cached until it expires, if expires_at is missing
+// the command is run on every IAMToken call.
+type ExecCredentials struct {
+	cfg ExecConfig
+
+	// mu guards token and excludes simultaneous command runs
+	mu    sync.Mutex
+	token *iamkey.CreateIamTokenResponse
+}
+
+var _ NonExchangeableCredentials = &ExecCredentials{}
+
+func NewExecCredentials(cfg ExecConfig) (*ExecCredentials, error) {
+	if cfg.Command == "" {
+		return nil, errors.New("exec credentials command is missing")
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultExecTimeout
+	}
+	return &ExecCredentials{cfg: cfg}, nil
+}
+
+func (c *ExecCredentials) DCAPICredentials() {}
+
+func (c *ExecCredentials) IAMToken(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != nil && c.token.GetExpiresAt().AsTime().Add(-execTokenExpirySkew).After(time.Now()) {
+		return proto.Clone(c.token).(*iamkey.CreateIamTokenResponse), nil
+	}
+	token, err := c.run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token.ExpiresAt != nil {
+		c.token = token
+	}
+	return proto.Clone(token).(*iamkey.CreateIamTokenResponse), nil
+}
+
+func (c *ExecCredentials) run(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, c.cfg.Command, c.cfg.Args...)
+	cmd.Env = append(os.Environ(), c.cfg.Env...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxExecStderrLen {
+			msg = msg[:maxExecStderrLen] + "..."
+		}
+		if msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, sdkerrors.WithMessagef(err, "exec credentials command '%s' failed", c.cfg.Command)
+	}
+
+	token := &iamkey.CreateIamTokenResponse{}
+	err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(stdout.Bytes(), token)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "exec credentials command '%s' output unmarshal fail", c.cfg.Command)
+	}
+	if token.IamToken == "" {
+		return nil, fmt.Errorf("exec credentials command '%s' returned empty iam_token", c.cfg.Command)
+	}
+	return token, nil
+}
@@ -0,0 +1,49 @@
+package dcsdk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecCredentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test command requires sh")
+	}
+	counter := filepath.Join(t.TempDir(), "runs")
+	expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	creds, err := NewExecCredentials(ExecConfig{
+		Command: "sh",
+		Args:    []string{"-c", `echo run >> "$RUNS" && echo '{"iamToken": "exec-token", "expiresAt": "` + expiresAt + `", "unknown": 1}'`},
+		Env:     []string{"RUNS=" + counter},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		token, err := creds.IAMToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "exec-token", token.IamToken)
+		assert.Equal(t, expiresAt, token.ExpiresAt.AsTime().Format(time.RFC3339))
+	}
+	runs, err := os.ReadFile(counter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(runs), "run"), "token must be cached until expiry")
+}
+
+func TestExecCredentials_CommandFailed(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test command requires sh")
+	}
+	creds, err := NewExecCredentials(ExecConfig{Command: "sh", Args: []string{"-c", "echo 'broker is down' >&2; exit 3"}})
+	require.NoError(t, err)
+	_, err = creds.IAMToken(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "broker is down")
+}
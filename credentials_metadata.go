@@ -0,0 +1,164 @@
+package dcsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// MetadataURLEnv is a URL of the local token endpoint used by MetadataCredentials by default.
+const MetadataURLEnv = "DOUBLE_CLOUD_METADATA_URL"
+
+const (
+	DefaultMetadataTimeout = 5 * time.Second
+	// metadataTokenExpirySkew is how long before expires_at cached token is considered expired.
+	metadataTokenExpirySkew = time.Minute
+	maxMetadataBodyLen      = 1024
+)
+
+// MetadataConfig configures MetadataCredentials.
+type MetadataConfig struct {
+	// URL is a local endpoint that responds to GET with IAM token.
+	// Default value: value of MetadataURLEnv environment variable
+	URL string
+	// Headers are added to every request, e.g. a header required by the sidecar to prevent SSRF.
+	Headers http.Header
+	// Timeout limits a single request.
+	// Default value: DefaultMetadataTimeout
+	Timeout time.Duration
+	// MaxRetries limits retries of failed requests, negative value disables retries.
+	// Default value: DefaultTokenExchangeMaxRetries
+	MaxRetries int
+	// RetryBackoff is a delay before the first retry, doubled on every next one.
+	// Default value: DefaultTokenExchangeRetryBackoff
+	RetryBackoff time.Duration
+	// Client is an HTTP client used for requests. http.DefaultClient is used if nil.
+	Client *http.Client
+}
+
+// MetadataError is returned when metadata endpoint responds with non 200 status.
+type MetadataError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *MetadataError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("metadata endpoint responded with %s", e.Status)
+	}
+	return fmt.Sprintf("metadata endpoint responded with %s: %s", e.Status, e.Body)
+}
+
+// Temporary reports whether the request may succeed on retry.
+func (e *MetadataError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// MetadataCredentials get IAM Token from a local HTTP endpoint, like a sidecar or a VM metadata service.
+// The endpoint must respond to GET with JSON object with IAM token and expiration time in RFC3339 format:
+//
+//	{"iam_token": "...", "expires_at": "2024-01-02T03:04:05Z"}
+//
+// camelCase field names are accepted too. Token is cached until it expires, if expires_at is missing
+// the endpoint is requested on every IAMToken call. Temporary failures are retried.
+type MetadataCredentials struct {
+	cfg MetadataConfig
+
+	// mu guards token and excludes simultaneous requests
+	mu    sync.Mutex
+	token *iamkey.CreateIamTokenResponse
+}
+
+var _ NonExchangeableCredentials = &MetadataCredentials{}
+
+func NewMetadataCredentials(cfg MetadataConfig) (*MetadataCredentials, error) {
+	cfg.URL = valueOrEnv(cfg.URL, MetadataURLEnv)
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("metadata URL is missing, set it in config or %s environment variable", MetadataURLEnv)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultMetadataTimeout
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	return &MetadataCredentials{cfg: cfg}, nil
+}
+
+func (c *MetadataCredentials) DCAPICredentials() {}
+
+func (c *MetadataCredentials) IAMToken(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != nil && c.token.GetExpiresAt().AsTime().Add(-metadataTokenExpirySkew).After(time.Now()) {
+		return proto.Clone(c.token).(*iamkey.CreateIamTokenResponse), nil
+	}
+	var token *iamkey.CreateIamTokenResponse
+	err := retryTemporary(ctx, c.cfg.MaxRetries, c.cfg.RetryBackoff, "Metadata token request", func() error {
+		var err error
+		token, err = c.fetch(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "failed to get IAM token from '%s'", c.cfg.URL)
+	}
+	if token.ExpiresAt != nil {
+		c.token = token
+	}
+	return proto.Clone(token).(*iamkey.CreateIamTokenResponse), nil
+}
+
+func (c *MetadataCredentials) fetch(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.cfg.URL, nil)
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "request make failed")
+	}
+	for name, values := range c.cfg.Headers {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.cfg.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		msg := strings.TrimSpace(string(body))
+		if len(msg) > maxMetadataBodyLen {
+			msg = msg[:maxMetadataBodyLen] + "..."
+		}
+		return nil, &MetadataError{StatusCode: resp.StatusCode, Status: resp.Status, Body: msg}
+	}
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "response read failed")
+	}
+
+	token := &iamkey.CreateIamTokenResponse{}
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, token)
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "response unmarshal fail")
+	}
+	if token.IamToken == "" {
+		return nil, errors.New("metadata endpoint returned empty iam_token")
+	}
+	return token, nil
+}
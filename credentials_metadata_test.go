@@ -0,0 +1,74 @@
+package dcsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataCredentials_RetryAndCache(t *testing.T) {
+	var calls int32
+	expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.Header.Get("Metadata-Flavor"))
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"iamToken":"iam-token","expiresAt":"` + expiresAt + `","extra":1}`))
+	}))
+	defer srv.Close()
+
+	creds, err := NewMetadataCredentials(MetadataConfig{
+		URL:          srv.URL,
+		Headers:      http.Header{"Metadata-Flavor": []string{"true"}},
+		RetryBackoff: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		token, err := creds.IAMToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "iam-token", token.IamToken)
+		assert.Equal(t, expiresAt, token.ExpiresAt.AsTime().Format(time.RFC3339))
+	}
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls), "token must be cached after successful retry")
+}
+
+func TestMetadataCredentials_ClientError(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		http.Error(w, "no token for this workload", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	creds, err := NewMetadataCredentials(MetadataConfig{URL: srv.URL, RetryBackoff: time.Millisecond})
+	require.NoError(t, err)
+	_, err = creds.IAMToken(context.Background())
+	require.Error(t, err)
+
+	var metadataErr *MetadataError
+	require.True(t, errors.As(err, &metadataErr))
+	assert.Equal(t, http.StatusForbidden, metadataErr.StatusCode)
+	assert.Equal(t, "no token for this workload", metadataErr.Body)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls), "client errors must not be retried")
+}
+
+func TestMetadataCredentials_URLFromEnv(t *testing.T) {
+	t.Setenv(MetadataURLEnv, "")
+	_, err := NewMetadataCredentials(MetadataConfig{})
+	require.Error(t, err)
+
+	t.Setenv(MetadataURLEnv, "http://127.0.0.1:8080/token")
+	creds, err := NewMetadataCredentials(MetadataConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8080/token", creds.cfg.URL)
+}
@@ -0,0 +1,213 @@
+package dcsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/grpc/grpclog"
+)
+
+const DefaultKeyPollInterval = 30 * time.Second
+
+// RotatingCredentialsConfig configures RotatingCredentials.
+type RotatingCredentialsConfig struct {
+	// Path is either a service account key file, or a directory with JSON or YAML key files.
+	// When a directory is used, the newest valid key by created_at is used.
+	Path string
+	// PollInterval is how often Path is checked for changes.
+	// Default value: DefaultKeyPollInterval
+	PollInterval time.Duration
+	// OnReload is called after every reload attempt caused by a change of Path.
+	// On failure err is set and the previous key is still used.
+	OnReload func(key *iamkey.Key, err error)
+}
+
+// RotatingCredentials are ServiceAccountKey credentials that reload the key when its file changes,
+// so long-running services pick up rotated keys without restart.
+// Invalid keys are rejected and the previous valid key is used until a valid one appears.
+type RotatingCredentials struct {
+	cfg RotatingCredentialsConfig
+
+	// mu guards builder and fingerprint
+	mu          sync.RWMutex
+	builder     *serviceAccountJWTBuilder
+	fingerprint string
+}
+
+var _ ExchangeableCredentials = &RotatingCredentials{}
+
+// NewRotatingCredentials loads the key from cfg.Path and watches it for changes until ctx is done.
+func NewRotatingCredentials(ctx context.Context, cfg RotatingCredentialsConfig) (*RotatingCredentials, error) {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultKeyPollInterval
+	}
+	c := &RotatingCredentials{cfg: cfg}
+	fingerprint, err := keyFilesFingerprint(cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.reload(fingerprint); err != nil {
+		return nil, err
+	}
+	go c.watch(ctx)
+	return c, nil
+}
+
+func (c *RotatingCredentials) DCAPICredentials() {}
+
+func (c *RotatingCredentials) IAMTokenRequest() (*iamkey.CreateIamTokenRequest, error) {
+	c.mu.RLock()
+	builder := c.builder
+	c.mu.RUnlock()
+	return builder.IAMTokenRequest()
+}
+
+// Key returns currently used key.
+func (c *RotatingCredentials) Key() *iamkey.Key {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.builder.key
+}
+
+// Reload reloads the key from Path immediately, regardless of whether its files changed.
+func (c *RotatingCredentials) Reload() (*iamkey.Key, error) {
+	fingerprint, err := keyFilesFingerprint(c.cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+	return c.reload(fingerprint)
+}
+
+func (c *RotatingCredentials) watch(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		fingerprint, err := keyFilesFingerprint(c.cfg.Path)
+		if err != nil {
+			c.reloaded(nil, err)
+			continue
+		}
+		c.mu.RLock()
+		changed := fingerprint != c.fingerprint
+		c.mu.RUnlock()
+		if !changed {
+			continue
+		}
+		key, err := c.reload(fingerprint)
+		c.reloaded(key, err)
+	}
+}
+
+func (c *RotatingCredentials) reloaded(key *iamkey.Key, err error) {
+	if err != nil {
+		grpclog.Warningf("Service account key reload from '%s' failed, keep using previous key: %v", c.cfg.Path, err)
+	} else {
+		grpclog.Infof("Service account key reloaded from '%s', key id: %s", c.cfg.Path, key.Id)
+	}
+	if c.cfg.OnReload != nil {
+		c.cfg.OnReload(key, err)
+	}
+}
+
+func (c *RotatingCredentials) reload(fingerprint string) (*iamkey.Key, error) {
+	builder, err := loadNewestKey(c.cfg.Path)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Remember fingerprint even on failure, so the same broken files are not reported on every poll.
+	c.fingerprint = fingerprint
+	if err != nil {
+		return nil, err
+	}
+	c.builder = builder
+	return builder.key, nil
+}
+
+// loadNewestKey loads the key file at path, or the newest valid key from directory at path.
+func loadNewestKey(path string) (*serviceAccountJWTBuilder, error) {
+	files, err := keyFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	var newest *serviceAccountJWTBuilder
+	var errs []string
+	for _, file := range files {
+		key, err := iamkey.ReadFromFile(file)
+		if err == nil {
+			var builder *serviceAccountJWTBuilder
+			builder, err = newServiceAccountJWTBuilder(key)
+			if err == nil {
+				if newest == nil || key.GetCreatedAt().AsTime().After(newest.key.GetCreatedAt().AsTime()) {
+					newest = builder
+				}
+				continue
+			}
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", file, err))
+	}
+	if newest == nil {
+		if len(errs) == 0 {
+			return nil, fmt.Errorf("no key files found in '%s'", path)
+		}
+		return nil, errors.New("no valid keys found: " + strings.Join(errs, "; "))
+	}
+	return newest, nil
+}
+
+func keyFiles(path string) ([]string, error) {
+	st, err := os.Stat(path)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "key path '%s' stat fail", path)
+	}
+	if !st.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "key dir '%s' read fail", path)
+	}
+	var files []string
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		switch filepath.Ext(e.Name()) {
+		case ".json", ".yaml", ".yml":
+		default:
+			continue
+		}
+		files = append(files, filepath.Join(path, e.Name()))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// keyFilesFingerprint changes whenever any key file at path is added, removed or modified.
+func keyFilesFingerprint(path string) (string, error) {
+	files, err := keyFiles(path)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, file := range files {
+		st, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&b, "%s:%d:%d;", file, st.Size(), st.ModTime().UnixNano())
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,73 @@
+package dcsdk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateTestKey(t *testing.T, id string) *iamkey.Key {
+	key, err := iamkey.Generate("sa-id", iamkey.Key_RSA_2048)
+	require.NoError(t, err)
+	key.Id = id
+	return key
+}
+
+func signedKeyID(t *testing.T, creds ExchangeableCredentials) string {
+	req, err := creds.IAMTokenRequest()
+	require.NoError(t, err)
+	token, _, err := jwt.NewParser().ParseUnverified(req.GetJwt(), &jwt.RegisteredClaims{})
+	require.NoError(t, err)
+	return token.Header["kid"].(string)
+}
+
+func TestRotatingCredentials_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, iamkey.WriteToJSONFile(path, generateTestKey(t, "key-1")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloaded := make(chan error, 10)
+	creds, err := NewRotatingCredentials(ctx, RotatingCredentialsConfig{
+		Path:         path,
+		PollInterval: 10 * time.Millisecond,
+		OnReload:     func(key *iamkey.Key, err error) { reloaded <- err },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", signedKeyID(t, creds))
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"id":"broken"}`), 0600))
+	require.Error(t, <-reloaded)
+	assert.Equal(t, "key-1", signedKeyID(t, creds), "invalid key must not replace valid one")
+
+	require.NoError(t, iamkey.WriteToJSONFile(path, generateTestKey(t, "key-2")))
+	// Poll may catch partially written file, so wait for successful reload.
+	for err := range reloaded {
+		if err == nil {
+			break
+		}
+	}
+	assert.Equal(t, "key-2", signedKeyID(t, creds))
+}
+
+func TestRotatingCredentials_Dir(t *testing.T) {
+	dir := t.TempDir()
+	older := generateTestKey(t, "key-old")
+	older.CreatedAt.Seconds -= 3600
+	require.NoError(t, iamkey.WriteToJSONFile(filepath.Join(dir, "b.json"), older))
+	require.NoError(t, iamkey.WriteToJSONFile(filepath.Join(dir, "a.json"), generateTestKey(t, "key-new")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	creds, err := NewRotatingCredentials(ctx, RotatingCredentialsConfig{Path: dir})
+	require.NoError(t, err)
+	assert.Equal(t, "key-new", creds.Key().Id)
+	assert.Equal(t, "key-new", signedKeyID(t, creds))
+}
@@ -0,0 +1,62 @@
+package dcsdk
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingSigner stands for a signing agent that holds the private key.
+type countingSigner struct {
+	key   *rsa.PrivateKey
+	calls int
+}
+
+func (s *countingSigner) Public() crypto.PublicKey {
+	return &s.key.PublicKey
+}
+
+func (s *countingSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	s.calls++
+	return s.key.Sign(rand, digest, opts)
+}
+
+func TestServiceAccountSigner(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer := &countingSigner{key: rsaKey}
+
+	creds, err := ServiceAccountSigner("key-id", "sa-id", signer)
+	require.NoError(t, err)
+	req, err := creds.(ExchangeableCredentials).IAMTokenRequest()
+	require.NoError(t, err)
+	assert.Equal(t, 1, signer.calls)
+
+	claims := &jwt.RegisteredClaims{}
+	token, err := jwt.ParseWithClaims(req.GetJwt(), claims, func(token *jwt.Token) (interface{}, error) {
+		return &rsaKey.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"PS256"}))
+	require.NoError(t, err)
+	assert.Equal(t, "key-id", token.Header["kid"])
+	assert.Equal(t, "sa-id", claims.Issuer)
+	assert.Equal(t, "sa-id", claims.Subject)
+	assert.True(t, claims.VerifyAudience(tokenURL(), true))
+}
+
+func TestServiceAccountSigner_Validation(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, err = ServiceAccountSigner("", "sa-id", rsaKey)
+	assert.Error(t, err)
+	_, err = ServiceAccountSigner("key-id", "", rsaKey)
+	assert.Error(t, err)
+	_, err = ServiceAccountSigner("key-id", "sa-id", nil)
+	assert.Error(t, err)
+}
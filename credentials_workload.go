@@ -0,0 +1,83 @@
+package dcsdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+)
+
+// OIDCTokenFileEnv is a path to OIDC token file used by WorkloadIdentityCredentials by default.
+const OIDCTokenFileEnv = "DOUBLE_CLOUD_OIDC_TOKEN_FILE"
+
+// SubjectTokenCredentials can be exchanged for IAM Token at the token endpoint with
+// OAuth 2.0 Token Exchange (RFC 8693) grant, see TokenExchanger.ExchangeSubjectToken.
+type SubjectTokenCredentials interface {
+	Credentials
+	// SubjectTokenRequest returns request with fresh external identity token or error.
+	SubjectTokenRequest(ctx context.Context) (*SubjectTokenRequest, error)
+}
+
+// WorkloadIdentityConfig configures WorkloadIdentityCredentials.
+type WorkloadIdentityConfig struct {
+	// TokenPath is a path to the file with OIDC token, e.g. Kubernetes projected service account token.
+	// DOUBLE_CLOUD_OIDC_TOKEN_FILE env is used when empty.
+	TokenPath string
+	// TokenType is a subject token type.
+	// Default value: JWTTokenType
+	TokenType string
+	// Audience is optional audience passed to the token endpoint.
+	Audience string
+}
+
+// WorkloadIdentityCredentials returns credentials that exchange OIDC token for IAM Token.
+// The token file is read on every refresh, so tokens rotated by the platform are picked up,
+// and no long-lived service account keys have to be distributed to workloads.
+func WorkloadIdentityCredentials(cfg WorkloadIdentityConfig) (Credentials, error) {
+	cfg.TokenPath = valueOrEnv(cfg.TokenPath, OIDCTokenFileEnv)
+	if cfg.TokenPath == "" {
+		return nil, errors.New("OIDC token path is missing")
+	}
+	if cfg.TokenType == "" {
+		cfg.TokenType = JWTTokenType
+	}
+	creds := &workloadIdentityCredentials{cfg: cfg}
+	if _, err := creds.readToken(); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
+
+type workloadIdentityCredentials struct {
+	cfg WorkloadIdentityConfig
+}
+
+var _ SubjectTokenCredentials = &workloadIdentityCredentials{}
+
+func (c *workloadIdentityCredentials) DCAPICredentials() {}
+
+func (c *workloadIdentityCredentials) SubjectTokenRequest(ctx context.Context) (*SubjectTokenRequest, error) {
+	token, err := c.readToken()
+	if err != nil {
+		return nil, err
+	}
+	return &SubjectTokenRequest{
+		SubjectToken:     token,
+		SubjectTokenType: c.cfg.TokenType,
+		Audience:         c.cfg.Audience,
+	}, nil
+}
+
+func (c *workloadIdentityCredentials) readToken() (string, error) {
+	data, err := os.ReadFile(c.cfg.TokenPath)
+	if err != nil {
+		return "", sdkerrors.WithMessagef(err, "OIDC token file '%s' read fail", c.cfg.TokenPath)
+	}
+	token := string(bytes.TrimSpace(data))
+	if token == "" {
+		return "", errors.New("OIDC token file '" + c.cfg.TokenPath + "' is empty")
+	}
+	return token, nil
+}
@@ -0,0 +1,76 @@
+package dcsdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWorkloadIdentityCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, tokenExchangeGrantType, r.PostForm.Get("grant_type"))
+		assert.Equal(t, JWTTokenType, r.PostForm.Get("subject_token_type"))
+		_, _ = w.Write([]byte(`{"access_token":"iam-for-` + r.PostForm.Get("subject_token") + `","expires_in":3600}`))
+	}))
+	defer srv.Close()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("oidc-1\n"), 0600))
+	creds, err := WorkloadIdentityCredentials(WorkloadIdentityConfig{TokenPath: tokenPath})
+	require.NoError(t, err)
+
+	sdk, err := Build(context.Background(), Config{
+		Credentials:    creds,
+		TokenExchanger: &HTTPTokenExchanger{TokenURL: srv.URL},
+	})
+	require.NoError(t, err)
+
+	resp, err := sdk.CreateIAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "iam-for-oidc-1", resp.IamToken)
+
+	// Projected tokens are rotated in place, every refresh must read the file again.
+	require.NoError(t, os.WriteFile(tokenPath, []byte("oidc-2"), 0600))
+	resp, err = sdk.CreateIAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "iam-for-oidc-2", resp.IamToken)
+}
+
+func TestWorkloadIdentityCredentials_MissingFile(t *testing.T) {
+	_, err := WorkloadIdentityCredentials(WorkloadIdentityConfig{TokenPath: filepath.Join(t.TempDir(), "missing")})
+	assert.Error(t, err)
+}
+
+// staticExchanger is a custom TokenExchanger that issues IAM tokens without calling the token endpoint.
+type staticExchanger struct{}
+
+func (staticExchanger) Exchange(ctx context.Context, request *iamkey.CreateIamTokenRequest) (*iamkey.CreateIamTokenResponse, error) {
+	return &iamkey.CreateIamTokenResponse{IamToken: "iam-for-jwt", ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))}, nil
+}
+
+func (staticExchanger) ExchangeSubjectToken(ctx context.Context, request *SubjectTokenRequest) (*iamkey.CreateIamTokenResponse, error) {
+	return &iamkey.CreateIamTokenResponse{IamToken: "iam-for-" + request.SubjectToken, ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))}, nil
+}
+
+func TestWorkloadIdentityCredentials_CustomExchanger(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("oidc"), 0600))
+	creds, err := WorkloadIdentityCredentials(WorkloadIdentityConfig{TokenPath: tokenPath})
+	require.NoError(t, err)
+
+	sdk, err := Build(context.Background(), Config{Credentials: creds, TokenExchanger: staticExchanger{}})
+	require.NoError(t, err)
+	resp, err := sdk.CreateIAMToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "iam-for-oidc", resp.IamToken)
+}
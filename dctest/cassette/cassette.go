@@ -0,0 +1,370 @@
+// Package cassette records unary gRPC calls of the SDK to a cassette file and replays them,
+// so tests of workflows that use the API run against recorded sessions without credentials:
+//
+//	rec := cassette.NewRecorder(cassette.Options{})
+//	sdk, err := dcsdk.Build(ctx, conf, grpc.WithChainUnaryInterceptor(rec.UnaryInterceptor()))
+//	// ... run the workflow against the real API
+//	err = rec.Save("testdata/workflow.json")
+//
+//	rep, err := cassette.Load("testdata/workflow.json", cassette.Options{})
+//	sdk, err := dcsdk.Build(ctx, dcsdk.Config{Credentials: dcsdk.NoCredentials{}},
+//		grpc.WithChainUnaryInterceptor(rep.UnaryInterceptor()))
+//	// ... run the workflow against the cassette
+//
+// Requests and responses are stored as protojson. Values of secret fields are redacted, see Options.Redact.
+// Replayed call is matched by method and normalized request, calls with equal requests, e.g. operation polls,
+// get recorded responses in order of recording. Streaming calls are not supported.
+package cassette
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Redacted replaces string values of redacted fields.
+const Redacted = "REDACTED"
+
+// Options configures Recorder and Replayer. Recording and replaying must use the same options,
+// so requests are normalized the same way.
+type Options struct {
+	// Redact reports whether value of field at path must not be stored. Path consists of protojson names
+	// of fields from the root message separated by dots, e.g. "connectionInfo.password", list indices
+	// and map keys are not included. Only string and bytes values are replaced with Redacted,
+	// so redacted messages can be unmarshalled.
+	// Default value: DefaultRedact
+	Redact func(path string) bool
+	// Normalize modifies protojson representation of request of method before it's stored or matched,
+	// e.g. removes random resource names generated by the test.
+	Normalize func(method string, request map[string]interface{})
+}
+
+// DefaultRedact redacts passwords, secrets, private keys and tokens, including fields of messages with such names.
+// Page tokens are kept, so paged lists can be replayed.
+func DefaultRedact(path string) bool {
+	if path == "nextPage.token" || strings.HasSuffix(path, ".nextPage.token") {
+		return false
+	}
+	for _, field := range strings.Split(path, ".") {
+		if secretField(field) {
+			return true
+		}
+	}
+	return false
+}
+
+func secretField(field string) bool {
+	lower := strings.ToLower(field)
+	switch lower {
+	case "token", "iamtoken", "accesstoken", "refreshtoken", "jwt", "privatekey":
+		return true
+	}
+	return strings.Contains(lower, "password") || strings.Contains(lower, "secret")
+}
+
+// Cassette is a recorded session.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded unary call.
+type Interaction struct {
+	Method string `json:"method"`
+	// Request is normalized and redacted request.
+	Request json.RawMessage `json:"request"`
+	// Response is redacted response. Empty if call failed.
+	Response json.RawMessage `json:"response,omitempty"`
+	// Status is google.rpc.Status of failed call.
+	Status json.RawMessage `json:"status,omitempty"`
+	// Header is response header, e.g. with operation poll interval.
+	Header metadata.MD `json:"header,omitempty"`
+}
+
+// Recorder records calls made through its interceptor. It is safe for concurrent use.
+type Recorder struct {
+	opts Options
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder creates Recorder with empty cassette.
+func NewRecorder(opts Options) *Recorder {
+	return &Recorder{opts: opts}
+}
+
+// UnaryInterceptor returns client interceptor that makes calls and records them.
+func (r *Recorder) UnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var header metadata.MD
+		callErr := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+
+		interaction := &Interaction{Method: method, Header: responseHeader(header)}
+		var err error
+		interaction.Request, err = r.opts.request(method, req)
+		if err != nil {
+			return sdkerrors.WithMessagef(err, "cassette: %s request record failed", method)
+		}
+		if callErr != nil {
+			interaction.Status, err = marshal(status.Convert(callErr).Proto(), r.opts.redact)
+		} else {
+			interaction.Response, err = marshal(reply, r.opts.redact)
+		}
+		if err != nil {
+			return sdkerrors.WithMessagef(err, "cassette: %s response record failed", method)
+		}
+
+		r.mu.Lock()
+		r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+		r.mu.Unlock()
+		return callErr
+	}
+}
+
+// Cassette returns calls recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Cassette{Interactions: append([]*Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save writes calls recorded so far to file at path.
+func (r *Recorder) Save(path string) error {
+	data, err := json.MarshalIndent(r.Cassette(), "", "  ")
+	if err != nil {
+		return sdkerrors.WithMessage(err, "cassette marshal failed")
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Replayer responds to calls with recorded responses without calling the server.
+// It is safe for concurrent use.
+type Replayer struct {
+	opts Options
+
+	mu sync.Mutex
+	// interactions are recorded interactions by method and request, replayed ones are removed
+	// except the last one, that is replayed for all further calls.
+	interactions map[string][]*Interaction
+}
+
+// Load reads cassette file saved by Recorder.
+func Load(path string, opts Options) (*Replayer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, sdkerrors.WithMessagef(err, "cassette %s unmarshal failed", path)
+	}
+	return NewReplayer(&c, opts), nil
+}
+
+// NewReplayer creates Replayer of cassette c.
+func NewReplayer(c *Cassette, opts Options) *Replayer {
+	r := &Replayer{opts: opts, interactions: map[string][]*Interaction{}}
+	for _, i := range c.Interactions {
+		// Cassette file is indented, while requests are matched by compact JSON.
+		var request bytes.Buffer
+		if err := json.Compact(&request, i.Request); err != nil {
+			request.Reset()
+			request.Write(i.Request)
+		}
+		key := interactionKey(i.Method, request.Bytes())
+		r.interactions[key] = append(r.interactions[key], i)
+	}
+	return r
+}
+
+// UnaryInterceptor returns client interceptor that replays recorded calls.
+// Call that was not recorded fails with INTERNAL status.
+func (r *Replayer) UnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		request, err := r.opts.request(method, req)
+		if err != nil {
+			return sdkerrors.WithMessagef(err, "cassette: %s request marshal failed", method)
+		}
+		interaction := r.next(interactionKey(method, request))
+		if interaction == nil {
+			return status.Errorf(codes.Internal, "cassette: no recorded call of %s with request %s", method, request)
+		}
+
+		for _, opt := range opts {
+			if h, ok := opt.(grpc.HeaderCallOption); ok {
+				*h.HeaderAddr = interaction.Header.Copy()
+			}
+		}
+		if len(interaction.Status) > 0 {
+			st := &spb.Status{}
+			if err := protojson.Unmarshal(interaction.Status, st); err != nil {
+				return sdkerrors.WithMessagef(err, "cassette: %s status unmarshal failed", method)
+			}
+			return status.ErrorProto(st)
+		}
+		msg, ok := reply.(proto.Message)
+		if !ok {
+			return fmt.Errorf("cassette: %s reply %T is not proto message", method, reply)
+		}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(interaction.Response, msg); err != nil {
+			return sdkerrors.WithMessagef(err, "cassette: %s response unmarshal failed", method)
+		}
+		return nil
+	}
+}
+
+func (r *Replayer) next(key string) *Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	queue := r.interactions[key]
+	if len(queue) == 0 {
+		return nil
+	}
+	if len(queue) > 1 {
+		r.interactions[key] = queue[1:]
+	}
+	return queue[0]
+}
+
+// request returns canonical JSON of normalized and redacted request.
+func (o Options) request(method string, req interface{}) (json.RawMessage, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("request %T is not proto message", req)
+	}
+	var fields map[string]interface{}
+	if err := decode(redacted(msg, o.redact), &fields); err != nil {
+		return nil, err
+	}
+	if o.Normalize != nil {
+		o.Normalize(method, fields)
+	}
+	return json.Marshal(fields)
+}
+
+func (o Options) redact(path string) bool {
+	if o.Redact != nil {
+		return o.Redact(path)
+	}
+	return DefaultRedact(path)
+}
+
+// marshal returns canonical JSON of redacted msg.
+func marshal(msg interface{}, isRedacted func(string) bool) (json.RawMessage, error) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not proto message", msg)
+	}
+	var fields map[string]interface{}
+	if err := decode(redacted(m, isRedacted), &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// decode converts msg to generic JSON value, protojson output is unstable by design,
+// while encoding/json sorts map keys.
+func decode(msg proto.Message, v interface{}) error {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// redacted returns copy of msg with redacted values replaced.
+func redacted(msg proto.Message, isRedacted func(string) bool) proto.Message {
+	msg = proto.Clone(msg)
+	redactMessage(msg.ProtoReflect(), "", isRedacted)
+	return msg
+}
+
+func redactMessage(m protoreflect.Message, path string, isRedacted func(string) bool) {
+	if a, ok := m.Interface().(*anypb.Any); ok {
+		// protojson inlines fields of Any, e.g. status details, so they have the same path.
+		if inner, err := a.UnmarshalNew(); err == nil {
+			redactMessage(inner.ProtoReflect(), path, isRedacted)
+			_ = a.MarshalFrom(inner)
+		}
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fieldPath := fd.JSONName()
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				list.Set(i, redactValue(fd, list.Get(i), fieldPath, isRedacted))
+			}
+		case fd.IsMap():
+			values := v.Map()
+			var keys []protoreflect.MapKey
+			values.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			for _, k := range keys {
+				values.Set(k, redactValue(fd.MapValue(), values.Get(k), fieldPath, isRedacted))
+			}
+		default:
+			m.Set(fd, redactValue(fd, v, fieldPath, isRedacted))
+		}
+		return true
+	})
+}
+
+// redactValue returns Redacted for string and bytes value of redacted field, and redacts fields of message value.
+func redactValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string, isRedacted func(string) bool) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if isRedacted(path) {
+			return protoreflect.ValueOfString(Redacted)
+		}
+	case protoreflect.BytesKind:
+		if isRedacted(path) {
+			return protoreflect.ValueOfBytes([]byte(Redacted))
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		redactMessage(v.Message(), path, isRedacted)
+	}
+	return v
+}
+
+// responseHeader drops transport headers.
+func responseHeader(header metadata.MD) metadata.MD {
+	result := metadata.MD{}
+	for key, values := range header {
+		if key == "content-type" || strings.HasPrefix(key, ":") {
+			continue
+		}
+		result[key] = values
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func interactionKey(method string, request json.RawMessage) string {
+	return method + " " + string(request)
+}
@@ -0,0 +1,168 @@
+package cassette
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/dctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// workflow creates cluster and returns it, as provisioning code under test would do.
+func workflow(ctx context.Context, sdk *dcsdk.SDK, name string) (*chv1.Cluster, error) {
+	op, err := sdk.WrapOperation(sdk.ClickHouse().Cluster().Create(ctx, &chv1.CreateClusterRequest{ProjectId: "p1", Name: name}))
+	if err != nil {
+		return nil, err
+	}
+	if err := op.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return sdk.ClickHouse().Cluster().Get(ctx, &chv1.GetClusterRequest{ClusterId: op.ResourceId()})
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "workflow.json")
+	opts := Options{
+		Normalize: func(method string, request map[string]interface{}) {
+			if method == chv1.ClusterService_Create_FullMethodName {
+				request["name"] = "cluster"
+			}
+		},
+	}
+
+	srv := dctest.NewServer(dctest.Config{OperationPolls: 3})
+	defer srv.Close()
+	rec := NewRecorder(opts)
+	sdk, err := dcsdk.Build(ctx, srv.Config(), srv.DialOption(), grpc.WithChainUnaryInterceptor(rec.UnaryInterceptor()))
+	require.NoError(t, err)
+	recorded, err := workflow(ctx, sdk, "cluster-1")
+	require.NoError(t, err)
+	_, err = sdk.ClickHouse().Cluster().Get(ctx, &chv1.GetClusterRequest{ClusterId: "unknown"})
+	require.Error(t, err)
+	require.NoError(t, rec.Save(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), recorded.ConnectionInfo.Password)
+	assert.Contains(t, string(data), Redacted)
+
+	rep, err := Load(path, opts)
+	require.NoError(t, err)
+	sdk, err = dcsdk.Build(ctx, dcsdk.Config{
+		Credentials:      dcsdk.NoCredentials{},
+		Endpoint:         "localhost:1",
+		OverrideEndpoint: true,
+		Plaintext:        true,
+	}, grpc.WithChainUnaryInterceptor(rep.UnaryInterceptor()))
+	require.NoError(t, err)
+	replayed, err := workflow(ctx, sdk, "cluster-2")
+	require.NoError(t, err)
+	assert.Equal(t, recorded.Id, replayed.Id)
+	assert.Equal(t, recorded.Status, replayed.Status)
+	assert.Equal(t, Redacted, replayed.ConnectionInfo.Password)
+
+	_, err = sdk.ClickHouse().Cluster().Get(ctx, &chv1.GetClusterRequest{ClusterId: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = sdk.ClickHouse().Cluster().Get(ctx, &chv1.GetClusterRequest{ClusterId: "not-recorded"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestRecordReplay_PagedList(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "list.json")
+	opts := Options{
+		Redact: func(path string) bool {
+			return DefaultRedact(path) || strings.HasPrefix(path, "clusters.resources")
+		},
+	}
+	list := func(sdk *dcsdk.SDK) ([]*chv1.Cluster, error) {
+		var clusters []*chv1.Cluster
+		paging := &dcv1.Paging{PageSize: 1}
+		for {
+			resp, err := sdk.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{ProjectId: "p1", Paging: paging})
+			if err != nil {
+				return nil, err
+			}
+			clusters = append(clusters, resp.Clusters...)
+			if resp.NextPage.GetToken() == "" {
+				return clusters, nil
+			}
+			paging.PageToken = resp.NextPage.Token
+		}
+	}
+
+	srv := dctest.NewServer(dctest.Config{})
+	defer srv.Close()
+	rec := NewRecorder(opts)
+	sdk, err := dcsdk.Build(ctx, srv.Config(), srv.DialOption(), grpc.WithChainUnaryInterceptor(rec.UnaryInterceptor()))
+	require.NoError(t, err)
+	for i, name := range []string{"a", "b", "c"} {
+		op, err := sdk.WrapOperation(sdk.ClickHouse().Cluster().Create(ctx, &chv1.CreateClusterRequest{
+			ProjectId: "p1",
+			Name:      name,
+			Resources: &chv1.ClusterResources{Clickhouse: &chv1.ClusterResources_Clickhouse{
+				ResourcePresetId: "s1-c2-m4",
+				DiskSize:         wrapperspb.Int64(int64(i+1) << 30),
+			}},
+		}))
+		require.NoError(t, err)
+		require.NoError(t, op.Wait(ctx))
+	}
+	recorded, err := list(sdk)
+	require.NoError(t, err)
+	require.Len(t, recorded, 3)
+	require.NoError(t, rec.Save(path))
+
+	rep, err := Load(path, opts)
+	require.NoError(t, err)
+	sdk, err = dcsdk.Build(ctx, dcsdk.Config{
+		Credentials:      dcsdk.NoCredentials{},
+		Endpoint:         "localhost:1",
+		OverrideEndpoint: true,
+		Plaintext:        true,
+	}, grpc.WithChainUnaryInterceptor(rep.UnaryInterceptor()))
+	require.NoError(t, err)
+	replayed, err := list(sdk)
+	require.NoError(t, err)
+	require.Len(t, replayed, 3)
+	for i, c := range replayed {
+		assert.Equal(t, recorded[i].Id, c.Id)
+		// Only strings of redacted message are replaced, other values are kept.
+		resources := c.Resources.GetClickhouse()
+		assert.Equal(t, Redacted, resources.ResourcePresetId)
+		assert.Equal(t, int64(i+1)<<30, resources.DiskSize.GetValue())
+		assert.Equal(t, Redacted, c.ConnectionInfo.Password)
+	}
+}
+
+func TestDefaultRedact(t *testing.T) {
+	for path, redacted := range map[string]bool{
+		"password":                 true,
+		"connectionInfo.password":  true,
+		"adminPassword":            true,
+		"clientSecret":             true,
+		"iamToken":                 true,
+		"privateKey":               true,
+		"secret.name":              true,
+		"token":                    true,
+		"nextPage.token":           false,
+		"operation.nextPage.token": false,
+		"paging.pageToken":         false,
+		"connectionInfo.host":      false,
+		"name":                     false,
+	} {
+		assert.Equal(t, redacted, DefaultRedact(path), path)
+	}
+}
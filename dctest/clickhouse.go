@@ -0,0 +1,497 @@
+package dctest
+
+import (
+	"context"
+	"fmt"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	clickhouseClusterPrefix = "chc"
+	clickhouseBackupPrefix  = "chb"
+	defaultClickHouseVer    = "24.3"
+)
+
+func (s *Server) registerClickHouse() {
+	chv1.RegisterClusterServiceServer(s.grpc, &clickhouseClusterService{s: s})
+	chv1.RegisterBackupServiceServer(s.grpc, &clickhouseBackupService{s: s})
+	chv1.RegisterOperationServiceServer(s.grpc, &clickhouseOperationService{s: s})
+	chv1.RegisterVersionServiceServer(s.grpc, &clickhouseVersionService{s: s})
+}
+
+// DefaultClickHouseVersions are ClickHouse versions listed by the server by default.
+var DefaultClickHouseVersions = []*chv1.Version{
+	{Id: "24.8", Name: "24.8 LTS"},
+	{Id: "24.3", Name: "24.3 LTS"},
+	{Id: "23.8", Name: "23.8 LTS", Deprecated: true},
+}
+
+type clickhouseClusterService struct {
+	chv1.UnimplementedClusterServiceServer
+	s *Server
+}
+
+func (c *clickhouseClusterService) Get(ctx context.Context, req *chv1.GetClusterRequest) (*chv1.Cluster, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.clickhouseCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	return clone(cluster), nil
+}
+
+func (c *clickhouseClusterService) List(ctx context.Context, req *chv1.ListClustersRequest) (*chv1.ListClustersResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	clusters := listSorted(c.s.chClusters, func(cluster *chv1.Cluster) bool {
+		return req.ProjectId == "" || cluster.ProjectId == req.ProjectId
+	})
+	clusters, next, err := pageNext(clusters, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &chv1.ListClustersResponse{Clusters: clusters, NextPage: next}, nil
+}
+
+func (c *clickhouseClusterService) Create(ctx context.Context, req *chv1.CreateClusterRequest) (*dcv1.Operation, error) {
+	if err := required("name", req.Name); err != nil {
+		return nil, err
+	}
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster := &chv1.Cluster{
+		Id:                c.s.newID(clickhouseClusterPrefix),
+		ProjectId:         projectOrDefault(req.ProjectId),
+		CloudType:         req.CloudType,
+		RegionId:          req.RegionId,
+		CreateTime:        timestamppb.Now(),
+		Name:              req.Name,
+		Description:       req.Description,
+		Status:            dcv1.ClusterStatus_CLUSTER_STATUS_CREATING,
+		Version:           req.Version,
+		Resources:         req.Resources,
+		Access:            req.Access,
+		Encryption:        req.Encryption,
+		NetworkId:         req.NetworkId,
+		ClickhouseConfig:  req.ClickhouseConfig,
+		MaintenanceWindow: req.MaintenanceWindow,
+	}
+	if cluster.Version == "" {
+		cluster.Version = defaultClickHouseVer
+	}
+	c.s.chClusters[cluster.Id] = cluster
+	return c.s.startOperation(serviceClickHouse, cluster.Id, "Create cluster", func() error {
+		return c.s.setClickHouseClusterAlive(cluster.Id)
+	}), nil
+}
+
+func (c *clickhouseClusterService) Update(ctx context.Context, req *chv1.UpdateClusterRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.clickhouseCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_UPDATING
+	update := clone(req)
+	return c.s.startOperation(serviceClickHouse, cluster.Id, "Update cluster", func() error {
+		cluster, err := c.s.clickhouseCluster(update.ClusterId)
+		if err != nil {
+			return err
+		}
+		if update.Name != "" {
+			cluster.Name = update.Name
+		}
+		if update.Description != "" {
+			cluster.Description = update.Description
+		}
+		if update.Version != "" {
+			cluster.Version = update.Version
+		}
+		if update.Resources != nil {
+			cluster.Resources = update.Resources
+		}
+		if update.Access != nil {
+			cluster.Access = update.Access
+		}
+		if update.ClickhouseConfig != nil {
+			cluster.ClickhouseConfig = update.ClickhouseConfig
+		}
+		if update.MaintenanceWindow != nil {
+			cluster.MaintenanceWindow = update.MaintenanceWindow
+		}
+		if update.CustomCertificate != nil {
+			cluster.CustomCertificate = update.CustomCertificate
+		}
+		cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE
+		return nil
+	}), nil
+}
+
+func (c *clickhouseClusterService) Delete(ctx context.Context, req *chv1.DeleteClusterRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.clickhouseCluster(req.ClusterId); err != nil {
+		return nil, err
+	}
+	id := req.ClusterId
+	return c.s.startOperation(serviceClickHouse, id, "Delete cluster", func() error {
+		if _, err := c.s.clickhouseCluster(id); err != nil {
+			return err
+		}
+		delete(c.s.chClusters, id)
+		return nil
+	}), nil
+}
+
+func (c *clickhouseClusterService) Start(ctx context.Context, req *chv1.StartClusterRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.clickhouseCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status != dcv1.ClusterStatus_CLUSTER_STATUS_STOPPED {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is not stopped", cluster.Id)
+	}
+	cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_STARTING
+	id := cluster.Id
+	return c.s.startOperation(serviceClickHouse, id, "Start cluster", func() error {
+		return c.s.setClickHouseClusterAlive(id)
+	}), nil
+}
+
+func (c *clickhouseClusterService) Stop(ctx context.Context, req *chv1.StopClusterRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.clickhouseCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status != dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is not running", cluster.Id)
+	}
+	cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_STOPPING
+	id := cluster.Id
+	return c.s.startOperation(serviceClickHouse, id, "Stop cluster", func() error {
+		cluster, err := c.s.clickhouseCluster(id)
+		if err != nil {
+			return err
+		}
+		cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_STOPPED
+		return nil
+	}), nil
+}
+
+func (c *clickhouseClusterService) RescheduleMaintenance(ctx context.Context, req *chv1.RescheduleMaintenanceRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.clickhouseCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	planned, err := rescheduledMaintenance(cluster.Id, cluster.MaintenanceOperation, req.RescheduleType, req.DelayedUntilTime)
+	if err != nil {
+		return nil, err
+	}
+	id := cluster.Id
+	return c.s.startOperation(serviceClickHouse, id, "Reschedule maintenance", func() error {
+		cluster, err := c.s.clickhouseCluster(id)
+		if err != nil {
+			return err
+		}
+		cluster.MaintenanceOperation = planned
+		return nil
+	}), nil
+}
+
+func (c *clickhouseClusterService) ListHosts(ctx context.Context, req *chv1.ListClusterHostsRequest) (*chv1.ListClusterHostsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.clickhouseCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	hostStatus := dcv1.HostStatus_HOST_STATUS_ALIVE
+	if cluster.Status != dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE {
+		hostStatus = dcv1.HostStatus_HOST_STATUS_CREATING
+	}
+	shards, replicas := int64(1), int64(1)
+	if resources := cluster.Resources.GetClickhouse(); resources != nil {
+		if n := resources.ShardCount.GetValue(); n > 0 {
+			shards = n
+		}
+		if n := resources.ReplicaCount.GetValue(); n > 0 {
+			replicas = n
+		}
+	}
+	var hosts []*chv1.Host
+	for shard := int64(1); shard <= shards; shard++ {
+		for replica := int64(1); replica <= replicas; replica++ {
+			hosts = append(hosts, &chv1.Host{
+				Name:        fmt.Sprintf("%s-s%d-r%d.dctest", cluster.Id, shard, replica),
+				ClusterId:   cluster.Id,
+				ShardName:   fmt.Sprintf("shard%d", shard),
+				PrivateName: fmt.Sprintf("%s-s%d-r%d.private.dctest", cluster.Id, shard, replica),
+				Status:      hostStatus,
+			})
+		}
+	}
+	hosts, next, err := pageNext(hosts, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &chv1.ListClusterHostsResponse{Hosts: hosts, NextPage: next}, nil
+}
+
+func (c *clickhouseClusterService) ListBackups(ctx context.Context, req *chv1.ListClusterBackupsRequest) (*chv1.ListClusterBackupsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.clickhouseCluster(req.ClusterId); err != nil {
+		return nil, err
+	}
+	backups := listSorted(c.s.chBackups, func(b *chv1.Backup) bool {
+		return b.SourceClusterId == req.ClusterId
+	})
+	backups, next, err := pageNext(backups, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &chv1.ListClusterBackupsResponse{Backups: backups, NextPage: next}, nil
+}
+
+func (c *clickhouseClusterService) Restore(ctx context.Context, req *chv1.RestoreClusterRequest) (*dcv1.Operation, error) {
+	if err := required("name", req.Name); err != nil {
+		return nil, err
+	}
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	backup, ok := c.s.chBackups[req.BackupId]
+	if !ok {
+		return nil, notFound("backup", req.BackupId)
+	}
+	cluster := &chv1.Cluster{
+		Id:                c.s.newID(clickhouseClusterPrefix),
+		ProjectId:         projectOrDefault(req.ProjectId),
+		RegionId:          req.RegionId,
+		CreateTime:        timestamppb.Now(),
+		Name:              req.Name,
+		Description:       req.Description,
+		Status:            dcv1.ClusterStatus_CLUSTER_STATUS_CREATING,
+		Version:           req.Version,
+		Resources:         req.Resources,
+		Access:            req.Access,
+		Encryption:        req.Encryption,
+		NetworkId:         req.NetworkId,
+		ClickhouseConfig:  req.ClickhouseConfig,
+		MaintenanceWindow: req.MaintenanceWindow,
+	}
+	if source, ok := c.s.chClusters[backup.SourceClusterId]; ok {
+		if cluster.Version == "" {
+			cluster.Version = source.Version
+		}
+		if cluster.Resources == nil {
+			cluster.Resources = clone(source.Resources)
+		}
+	}
+	if cluster.Version == "" {
+		cluster.Version = defaultClickHouseVer
+	}
+	c.s.chClusters[cluster.Id] = cluster
+	op := c.s.startOperation(serviceClickHouse, cluster.Id, "Restore cluster", func() error {
+		return c.s.setClickHouseClusterAlive(cluster.Id)
+	})
+	return c.s.setOperationMetadata(op, "backup_id", backup.Id), nil
+}
+
+func (c *clickhouseClusterService) ListOperations(ctx context.Context, req *chv1.ListClusterOperationsRequest) (*chv1.ListClusterOperationsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.clickhouseCluster(req.ClusterId); err != nil {
+		return nil, err
+	}
+	ops, next, err := pageNext(c.s.resourceOperations(serviceClickHouse, req.ClusterId), req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &chv1.ListClusterOperationsResponse{Operations: ops, NextPage: next}, nil
+}
+
+type clickhouseBackupService struct {
+	chv1.UnimplementedBackupServiceServer
+	s *Server
+}
+
+func (c *clickhouseBackupService) Get(ctx context.Context, req *chv1.GetBackupRequest) (*chv1.Backup, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	backup, ok := c.s.chBackups[req.BackupId]
+	if !ok {
+		return nil, notFound("backup", req.BackupId)
+	}
+	return clone(backup), nil
+}
+
+func (c *clickhouseBackupService) List(ctx context.Context, req *chv1.ListBackupsRequest) (*chv1.ListBackupsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	backups := listSorted(c.s.chBackups, func(b *chv1.Backup) bool {
+		return req.ProjectId == "" || b.ProjectId == req.ProjectId
+	})
+	backups, next, err := pageNext(backups, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &chv1.ListBackupsResponse{Backups: backups, NextPage: next}, nil
+}
+
+func (c *clickhouseBackupService) Create(ctx context.Context, req *chv1.CreateBackupRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.clickhouseCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	backupID := c.s.newID(clickhouseBackupPrefix)
+	clusterID, projectID, name := cluster.Id, cluster.ProjectId, req.Name
+	startTime := timestamppb.Now()
+	op := c.s.startOperation(serviceClickHouse, clusterID, "Create backup", func() error {
+		if _, err := c.s.clickhouseCluster(clusterID); err != nil {
+			return err
+		}
+		c.s.chBackups[backupID] = &chv1.Backup{
+			Id:              backupID,
+			ProjectId:       projectID,
+			Name:            name,
+			CreateTime:      timestamppb.Now(),
+			StartTime:       startTime,
+			SourceClusterId: clusterID,
+			Type:            chv1.Backup_TYPE_MANUAL,
+		}
+		return nil
+	})
+	return c.s.setOperationMetadata(op, "backup_id", backupID), nil
+}
+
+func (c *clickhouseBackupService) Delete(ctx context.Context, req *chv1.DeleteBackupRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	backup, ok := c.s.chBackups[req.BackupId]
+	if !ok {
+		return nil, notFound("backup", req.BackupId)
+	}
+	id := backup.Id
+	return c.s.startOperation(serviceClickHouse, backup.SourceClusterId, "Delete backup", func() error {
+		delete(c.s.chBackups, id)
+		return nil
+	}), nil
+}
+
+type clickhouseOperationService struct {
+	chv1.UnimplementedOperationServiceServer
+	s *Server
+}
+
+func (c *clickhouseOperationService) Get(ctx context.Context, req *chv1.GetOperationRequest) (*dcv1.Operation, error) {
+	return c.s.pollOperation(ctx, serviceClickHouse, req.OperationId)
+}
+
+type clickhouseVersionService struct {
+	chv1.UnimplementedVersionServiceServer
+	s *Server
+}
+
+func (c *clickhouseVersionService) List(ctx context.Context, req *chv1.ListVersionsRequest) (*chv1.ListVersionsResponse, error) {
+	versions := c.s.cfg.ClickHouseVersions
+	if versions == nil {
+		versions = DefaultClickHouseVersions
+	}
+	result := make([]*chv1.Version, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, clone(v))
+	}
+	result, next, err := pageNext(result, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &chv1.ListVersionsResponse{Versions: result, NextPage: next}, nil
+}
+
+// AddClickHouseBackup adds backup of the existing cluster, e.g. automated backup the real API makes on schedule.
+// Empty ID, project ID and create time are filled in. Returns the added backup.
+func (s *Server) AddClickHouseBackup(backup *chv1.Backup) (*chv1.Backup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cluster, err := s.clickhouseCluster(backup.SourceClusterId)
+	if err != nil {
+		return nil, err
+	}
+	backup = clone(backup)
+	if backup.Id == "" {
+		backup.Id = s.newID(clickhouseBackupPrefix)
+	}
+	if backup.ProjectId == "" {
+		backup.ProjectId = cluster.ProjectId
+	}
+	if backup.CreateTime == nil {
+		backup.CreateTime = timestamppb.Now()
+	}
+	if backup.Type == chv1.Backup_TYPE_INVALID {
+		backup.Type = chv1.Backup_TYPE_AUTOMATED
+	}
+	s.chBackups[backup.Id] = backup
+	return clone(backup), nil
+}
+
+// UpdateClickHouseCluster changes state of the existing cluster with update, e.g. sets status
+// the real API sets on its own.
+func (s *Server) UpdateClickHouseCluster(id string, update func(*chv1.Cluster)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cluster, err := s.clickhouseCluster(id)
+	if err != nil {
+		return err
+	}
+	update(cluster)
+	return nil
+}
+
+// clickhouseCluster must be called with mu held.
+func (s *Server) clickhouseCluster(id string) (*chv1.Cluster, error) {
+	cluster, ok := s.chClusters[id]
+	if !ok {
+		return nil, notFound("cluster", id)
+	}
+	return cluster, nil
+}
+
+// setClickHouseClusterAlive finishes cluster create or start. Must be called with mu held.
+func (s *Server) setClickHouseClusterAlive(id string) error {
+	cluster, err := s.clickhouseCluster(id)
+	if err != nil {
+		return err
+	}
+	cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE
+	if cluster.ConnectionInfo == nil {
+		host := fmt.Sprintf("%s.dctest", cluster.Id)
+		cluster.ConnectionInfo = &chv1.ConnectionInfo{
+			Host:           host,
+			User:           "admin",
+			Password:       "dctest-" + cluster.Id,
+			HttpsPort:      wrapperspb.Int64(8443),
+			TcpPortSecure:  wrapperspb.Int64(9440),
+			NativeProtocol: fmt.Sprintf("%s:9440", host),
+			HttpsUri:       fmt.Sprintf("https://%s:8443", host),
+			JdbcUri:        fmt.Sprintf("jdbc:clickhouse://%s:8443/default?ssl=true", host),
+			OdbcUri:        fmt.Sprintf("https://%s:8443", host),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package dctest
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Fault is a failure injected into calls of Method.
+type Fault struct {
+	// Method is full gRPC method name, e.g. chv1.ClusterService_Create_FullMethodName.
+	// Empty Method matches calls of any method.
+	Method string
+	// Times is how many matched calls are affected, 0 means every call until ClearFaults.
+	Times int
+	// Delay is added before the call is handled or failed. Call context is honoured.
+	Delay time.Duration
+	// Code fails the call with the status code instead of handling it, if not OK.
+	Code    codes.Code
+	Message string
+	// Drop closes all client connections instead of handling the call, so the call fails with
+	// UNAVAILABLE and the client has to reconnect.
+	Drop bool
+}
+
+// OperationFault changes the way operation progresses.
+// Visualization operations are done on creation and are not affected.
+type OperationFault struct {
+	// Method is full gRPC method name of the call that starts operations, used by InjectOperationFault.
+	// Empty Method matches calls of any method.
+	Method string
+	// Times is how many matched operations are affected, 0 means every operation until ClearFaults.
+	Times int
+	// ExtraPolls is how many polls are needed on top of Config.OperationPolls for operation to be done.
+	ExtraPolls int
+	// NotFoundPolls is how many first polls respond with NOT_FOUND, as if operation was not replicated yet.
+	NotFoundPolls int
+	// Code fails the operation with the status code, if not OK. The change of operation is not applied.
+	Code    codes.Code
+	Message string
+}
+
+type injectedFault struct {
+	Fault
+	left int
+}
+
+type injectedOperationFault struct {
+	OperationFault
+	left int
+}
+
+// match reports if fault for faultMethod affects call of method, and uses one of times left.
+func match(faultMethod string, left *int, method string) bool {
+	if faultMethod != "" && faultMethod != method {
+		return false
+	}
+	if *left > 0 {
+		*left--
+	}
+	return true
+}
+
+// InjectFault makes calls matched by f fail. Faults are matched in order of injection,
+// a call is affected by the first matched fault only.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &injectedFault{Fault: f, left: timesLeft(f.Times)})
+}
+
+// InjectOperationFault applies f to operations started by calls of f.Method.
+func (s *Server) InjectOperationFault(f OperationFault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.opFaults = append(s.opFaults, &injectedOperationFault{OperationFault: f, left: timesLeft(f.Times)})
+}
+
+// FaultOperation applies f to already started operation, f.Method and f.Times are ignored.
+// Polls made before the call count towards f.ExtraPolls.
+func (s *Server) FaultOperation(operationID string, f OperationFault) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.operations[operationID]
+	if !ok {
+		return notFound("operation", operationID)
+	}
+	if op.proto.Status == dcv1.Operation_STATUS_DONE {
+		return status.Errorf(codes.FailedPrecondition, "operation %q is done", operationID)
+	}
+	op.applyFault(f)
+	return nil
+}
+
+// ClearFaults removes all injected call and operation faults.
+// Faults already applied to operations and expired tokens stay.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+	s.opFaults = nil
+}
+
+// ExpireTokens makes all IAM tokens the server has seen so far invalid: calls with them fail with
+// UNAUTHENTICATED. Tokens seen for the first time are valid. Calls without token are not checked.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token := range s.tokens {
+		s.tokens[token] = true
+	}
+}
+
+// ExpireToken makes IAM token invalid, even if the server has not seen it yet.
+func (s *Server) ExpireToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[token] = true
+}
+
+// DropConnections closes all client connections. Calls in progress fail with UNAVAILABLE,
+// further calls make the client reconnect.
+func (s *Server) DropConnections() {
+	s.lis.dropAll()
+}
+
+// Calls returns how many calls of full method name the server has received,
+// including failed by faults and unauthenticated ones.
+func (s *Server) Calls(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *Server) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.beforeCall(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	if op, ok := resp.(*dcv1.Operation); ok && err == nil {
+		s.afterOperationStarted(info.FullMethod, op)
+	}
+	return resp, err
+}
+
+func (s *Server) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.beforeCall(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// beforeCall checks IAM token and applies the first fault matched by the call.
+func (s *Server) beforeCall(ctx context.Context, method string) error {
+	s.mu.Lock()
+	s.calls[method]++
+	s.mu.Unlock()
+	if s.cfg.TokenServer != nil {
+		if err := s.cfg.TokenServer.authenticate(ctx); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	if token := bearerToken(ctx); token != "" {
+		if s.tokens[token] {
+			s.mu.Unlock()
+			return status.Error(codes.Unauthenticated, "iam token expired")
+		}
+		s.tokens[token] = false
+	}
+	var fault *Fault
+	for i, f := range s.faults {
+		if match(f.Method, &f.left, method) {
+			fault = &f.Fault
+			if f.left == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if fault == nil {
+		return nil
+	}
+	if fault.Delay > 0 {
+		t := time.NewTimer(fault.Delay)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if fault.Drop {
+		s.lis.dropAll()
+		return status.Error(codes.Unavailable, "connection dropped")
+	}
+	if fault.Code != codes.OK {
+		return status.Error(fault.Code, fault.Message)
+	}
+	return nil
+}
+
+// afterOperationStarted applies the first operation fault matched by method to op started by the call.
+func (s *Server) afterOperationStarted(method string, op *dcv1.Operation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.operations[op.Id]
+	if !ok || stored.proto.Status == dcv1.Operation_STATUS_DONE {
+		return
+	}
+	for i, f := range s.opFaults {
+		if match(f.Method, &f.left, method) {
+			stored.applyFault(f.OperationFault)
+			if f.left == 0 {
+				s.opFaults = append(s.opFaults[:i:i], s.opFaults[i+1:]...)
+			}
+			return
+		}
+	}
+}
+
+func (op *fakeOperation) applyFault(f OperationFault) {
+	op.extraPolls += f.ExtraPolls
+	op.notFoundPolls += f.NotFoundPolls
+	if f.Code != codes.OK {
+		op.err = status.New(f.Code, f.Message)
+	}
+}
+
+// timesLeft converts Times of fault to counter of matches left, negative for unlimited.
+func timesLeft(times int) int {
+	if times <= 0 {
+		return -1
+	}
+	return times
+}
+
+func bearerToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if token := strings.TrimPrefix(v, "Bearer "); token != v {
+			return token
+		}
+	}
+	return ""
+}
+
+// listener tracks accepted connections, so they could be dropped.
+type listener struct {
+	*bufconn.Listener
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+func newListener() *listener {
+	return &listener{
+		Listener: bufconn.Listen(bufSize),
+		conns:    map[net.Conn]struct{}{},
+	}
+}
+
+func (l *listener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.conns[conn] = struct{}{}
+	return &trackedConn{Conn: conn, l: l}, nil
+}
+
+func (l *listener) dial(ctx context.Context, _ string) (net.Conn, error) {
+	return l.DialContext(ctx)
+}
+
+func (l *listener) dropAll() {
+	l.mu.Lock()
+	conns := l.conns
+	l.conns = map[net.Conn]struct{}{}
+	l.mu.Unlock()
+	for conn := range conns {
+		_ = conn.Close()
+	}
+}
+
+type trackedConn struct {
+	net.Conn
+	l *listener
+}
+
+func (c *trackedConn) Close() error {
+	c.l.mu.Lock()
+	delete(c.l.conns, c.Conn)
+	c.l.mu.Unlock()
+	return c.Conn.Close()
+}
@@ -0,0 +1,114 @@
+package dctest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_InjectFault(t *testing.T) {
+	ctx := context.Background()
+	srv, sdk := newSDK(t, Config{})
+	srv.InjectFault(Fault{Method: chv1.ClusterService_List_FullMethodName, Times: 2, Code: codes.Unavailable, Message: "try again"})
+
+	for i := 0; i < 2; i++ {
+		_, err := sdk.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	_, err := sdk.Kafka().Cluster().List(ctx, &kfv1.ListClustersRequest{})
+	require.NoError(t, err)
+	_, err = sdk.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, srv.Calls(chv1.ClusterService_List_FullMethodName))
+
+	srv.InjectFault(Fault{Delay: time.Minute})
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = sdk.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestServer_OperationFaults(t *testing.T) {
+	ctx := context.Background()
+	srv, sdk := newSDK(t, Config{})
+	clusters := sdk.ClickHouse().Cluster()
+
+	srv.InjectOperationFault(OperationFault{
+		Method:        chv1.ClusterService_Create_FullMethodName,
+		Times:         1,
+		NotFoundPolls: 3,
+		ExtraPolls:    2,
+	})
+	op, err := sdk.WrapOperation(clusters.Create(ctx, &chv1.CreateClusterRequest{Name: "delayed"}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	assert.Equal(t, 6, srv.Calls(chv1.OperationService_Get_FullMethodName))
+
+	srv.InjectOperationFault(OperationFault{NotFoundPolls: 4})
+	op, err = sdk.WrapOperation(clusters.Create(ctx, &chv1.CreateClusterRequest{Name: "lost"}))
+	require.NoError(t, err)
+	err = op.Wait(ctx)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	srv.ClearFaults()
+
+	op, err = sdk.WrapOperation(clusters.Create(ctx, &chv1.CreateClusterRequest{Name: "failed"}))
+	require.NoError(t, err)
+	require.NoError(t, srv.FaultOperation(op.Id(), OperationFault{Code: codes.ResourceExhausted, Message: "quota exceeded"}))
+	err = op.Wait(ctx)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.True(t, op.Failed())
+	cluster, err := clusters.Get(ctx, &chv1.GetClusterRequest{ClusterId: op.ResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, dcv1.ClusterStatus_CLUSTER_STATUS_CREATING, cluster.Status)
+	assert.Error(t, srv.FaultOperation(op.Id(), OperationFault{}))
+}
+
+func TestServer_ExpireTokens(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer(Config{})
+	defer srv.Close()
+	conn, err := srv.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := chv1.NewClusterServiceClient(conn)
+	list := func(token string) error {
+		ctx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		_, err := client.List(ctx, &chv1.ListClustersRequest{})
+		return err
+	}
+
+	require.NoError(t, list("t1"))
+	srv.ExpireTokens()
+	assert.Equal(t, codes.Unauthenticated, status.Code(list("t1")))
+	require.NoError(t, list("t2"))
+	srv.ExpireToken("t3")
+	assert.Equal(t, codes.Unauthenticated, status.Code(list("t3")))
+	_, err = client.List(ctx, &chv1.ListClustersRequest{})
+	require.NoError(t, err)
+}
+
+func TestServer_DropConnections(t *testing.T) {
+	ctx := context.Background()
+	srv, sdk := newSDK(t, Config{})
+	clusters := sdk.ClickHouse().Cluster()
+
+	_, err := clusters.List(ctx, &chv1.ListClustersRequest{})
+	require.NoError(t, err)
+	srv.InjectFault(Fault{Method: chv1.ClusterService_List_FullMethodName, Times: 1, Drop: true})
+	_, err = clusters.List(ctx, &chv1.ListClustersRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	require.Eventually(t, func() bool {
+		_, err = clusters.List(ctx, &chv1.ListClustersRequest{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,291 @@
+package dctest
+
+import (
+	"context"
+	"fmt"
+
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	kafkaClusterPrefix = "kfk"
+	defaultKafkaVer    = "3.5"
+)
+
+func (s *Server) registerKafka() {
+	kfv1.RegisterClusterServiceServer(s.grpc, &kafkaClusterService{s: s})
+	kfv1.RegisterOperationServiceServer(s.grpc, &kafkaOperationService{s: s})
+}
+
+type kafkaClusterService struct {
+	kfv1.UnimplementedClusterServiceServer
+	s *Server
+}
+
+func (c *kafkaClusterService) Get(ctx context.Context, req *kfv1.GetClusterRequest) (*kfv1.Cluster, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.kafkaCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	return clone(cluster), nil
+}
+
+func (c *kafkaClusterService) List(ctx context.Context, req *kfv1.ListClustersRequest) (*kfv1.ListClustersResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	clusters := listSorted(c.s.kfClusters, func(cluster *kfv1.Cluster) bool {
+		return req.ProjectId == "" || cluster.ProjectId == req.ProjectId
+	})
+	clusters, next, err := pageNext(clusters, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &kfv1.ListClustersResponse{Clusters: clusters, NextPage: next}, nil
+}
+
+func (c *kafkaClusterService) Create(ctx context.Context, req *kfv1.CreateClusterRequest) (*dcv1.Operation, error) {
+	if err := required("name", req.Name); err != nil {
+		return nil, err
+	}
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster := &kfv1.Cluster{
+		Id:                   c.s.newID(kafkaClusterPrefix),
+		ProjectId:            projectOrDefault(req.ProjectId),
+		CloudType:            req.CloudType,
+		RegionId:             req.RegionId,
+		CreateTime:           timestamppb.Now(),
+		Name:                 req.Name,
+		Description:          req.Description,
+		Status:               dcv1.ClusterStatus_CLUSTER_STATUS_CREATING,
+		Version:              req.Version,
+		Resources:            req.Resources,
+		Access:               req.Access,
+		Encryption:           req.Encryption,
+		NetworkId:            req.NetworkId,
+		MaintenanceWindow:    req.MaintenanceWindow,
+		KafkaConfig:          req.KafkaConfig,
+		SchemaRegistryConfig: req.SchemaRegistryConfig,
+		RestApiConfig:        req.RestApiConfig,
+	}
+	if cluster.Version == "" {
+		cluster.Version = defaultKafkaVer
+	}
+	c.s.kfClusters[cluster.Id] = cluster
+	return c.s.startOperation(serviceKafka, cluster.Id, "Create cluster", func() error {
+		return c.s.setKafkaClusterAlive(cluster.Id)
+	}), nil
+}
+
+func (c *kafkaClusterService) Update(ctx context.Context, req *kfv1.UpdateClusterRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.kafkaCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_UPDATING
+	update := clone(req)
+	return c.s.startOperation(serviceKafka, cluster.Id, "Update cluster", func() error {
+		cluster, err := c.s.kafkaCluster(update.ClusterId)
+		if err != nil {
+			return err
+		}
+		if update.Name != "" {
+			cluster.Name = update.Name
+		}
+		if update.Description != "" {
+			cluster.Description = update.Description
+		}
+		if update.Version != "" {
+			cluster.Version = update.Version
+		}
+		if update.Resources != nil {
+			cluster.Resources = update.Resources
+		}
+		if update.Access != nil {
+			cluster.Access = update.Access
+		}
+		if update.MaintenanceWindow != nil {
+			cluster.MaintenanceWindow = update.MaintenanceWindow
+		}
+		if update.KafkaConfig != nil {
+			cluster.KafkaConfig = update.KafkaConfig
+		}
+		if update.SchemaRegistryConfig != nil {
+			cluster.SchemaRegistryConfig = update.SchemaRegistryConfig
+		}
+		if update.RestApiConfig != nil {
+			cluster.RestApiConfig = update.RestApiConfig
+		}
+		cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE
+		return nil
+	}), nil
+}
+
+func (c *kafkaClusterService) Delete(ctx context.Context, req *kfv1.DeleteClusterRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.kafkaCluster(req.ClusterId); err != nil {
+		return nil, err
+	}
+	id := req.ClusterId
+	return c.s.startOperation(serviceKafka, id, "Delete cluster", func() error {
+		if _, err := c.s.kafkaCluster(id); err != nil {
+			return err
+		}
+		delete(c.s.kfClusters, id)
+		return nil
+	}), nil
+}
+
+func (c *kafkaClusterService) Start(ctx context.Context, req *kfv1.StartClusterRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.kafkaCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status != dcv1.ClusterStatus_CLUSTER_STATUS_STOPPED {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is not stopped", cluster.Id)
+	}
+	cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_STARTING
+	id := cluster.Id
+	return c.s.startOperation(serviceKafka, id, "Start cluster", func() error {
+		return c.s.setKafkaClusterAlive(id)
+	}), nil
+}
+
+func (c *kafkaClusterService) Stop(ctx context.Context, req *kfv1.StopClusterRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.kafkaCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status != dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is not running", cluster.Id)
+	}
+	cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_STOPPING
+	id := cluster.Id
+	return c.s.startOperation(serviceKafka, id, "Stop cluster", func() error {
+		cluster, err := c.s.kafkaCluster(id)
+		if err != nil {
+			return err
+		}
+		cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_STOPPED
+		return nil
+	}), nil
+}
+
+func (c *kafkaClusterService) RescheduleMaintenance(ctx context.Context, req *kfv1.RescheduleMaintenanceRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.kafkaCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	planned, err := rescheduledMaintenance(cluster.Id, cluster.PlannedOperation, req.RescheduleType, req.DelayedUntilTime)
+	if err != nil {
+		return nil, err
+	}
+	id := cluster.Id
+	return c.s.startOperation(serviceKafka, id, "Reschedule maintenance", func() error {
+		cluster, err := c.s.kafkaCluster(id)
+		if err != nil {
+			return err
+		}
+		cluster.PlannedOperation = planned
+		return nil
+	}), nil
+}
+
+func (c *kafkaClusterService) ListHosts(ctx context.Context, req *kfv1.ListClusterHostsRequest) (*kfv1.ListClusterHostsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	cluster, err := c.s.kafkaCluster(req.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	hostStatus := dcv1.HostStatus_HOST_STATUS_ALIVE
+	if cluster.Status != dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE {
+		hostStatus = dcv1.HostStatus_HOST_STATUS_CREATING
+	}
+	hosts := []*kfv1.Host{{
+		Name:      fmt.Sprintf("%s-1.dctest", cluster.Id),
+		ClusterId: cluster.Id,
+		Status:    hostStatus,
+	}}
+	hosts, next, err := pageNext(hosts, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &kfv1.ListClusterHostsResponse{Hosts: hosts, NextPage: next}, nil
+}
+
+func (c *kafkaClusterService) ListOperations(ctx context.Context, req *kfv1.ListClusterOperationsRequest) (*kfv1.ListClusterOperationsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.kafkaCluster(req.ClusterId); err != nil {
+		return nil, err
+	}
+	ops, next, err := pageNext(c.s.resourceOperations(serviceKafka, req.ClusterId), req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &kfv1.ListClusterOperationsResponse{Operations: ops, NextPage: next}, nil
+}
+
+type kafkaOperationService struct {
+	kfv1.UnimplementedOperationServiceServer
+	s *Server
+}
+
+func (c *kafkaOperationService) Get(ctx context.Context, req *kfv1.GetOperationRequest) (*dcv1.Operation, error) {
+	return c.s.pollOperation(ctx, serviceKafka, req.OperationId)
+}
+
+// UpdateKafkaCluster changes state of the existing cluster with update, e.g. sets status or planned maintenance
+// the real API sets on its own.
+func (s *Server) UpdateKafkaCluster(id string, update func(*kfv1.Cluster)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cluster, err := s.kafkaCluster(id)
+	if err != nil {
+		return err
+	}
+	update(cluster)
+	return nil
+}
+
+// kafkaCluster must be called with mu held.
+func (s *Server) kafkaCluster(id string) (*kfv1.Cluster, error) {
+	cluster, ok := s.kfClusters[id]
+	if !ok {
+		return nil, notFound("cluster", id)
+	}
+	return cluster, nil
+}
+
+// setKafkaClusterAlive finishes cluster create or start. Must be called with mu held.
+func (s *Server) setKafkaClusterAlive(id string) error {
+	cluster, err := s.kafkaCluster(id)
+	if err != nil {
+		return err
+	}
+	cluster.Status = dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE
+	if cluster.ConnectionInfo == nil {
+		cluster.ConnectionInfo = &kfv1.ConnectionInfo{
+			ConnectionString: fmt.Sprintf("%s.dctest:9091", cluster.Id),
+			User:             "admin",
+			Password:         "dctest-" + cluster.Id,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package dctest
+
+import (
+	"context"
+
+	lgv1 "github.com/doublecloud/go-genproto/doublecloud/logs/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+)
+
+const logsExportPrefix = "lge"
+
+func (s *Server) registerLogs() {
+	lgv1.RegisterLogExportServiceServer(s.grpc, &logsExportService{s: s})
+	lgv1.RegisterOperationServiceServer(s.grpc, &logsOperationService{s: s})
+}
+
+type logsExportService struct {
+	lgv1.UnimplementedLogExportServiceServer
+	s *Server
+}
+
+func (c *logsExportService) Get(ctx context.Context, req *lgv1.GetExportRequest) (*lgv1.LogsExport, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	export, err := c.s.logsExport(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return clone(export), nil
+}
+
+func (c *logsExportService) List(ctx context.Context, req *lgv1.ListExportRequest) (*lgv1.ListExportResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	exports := listSorted(c.s.exports, func(e *lgv1.LogsExport) bool {
+		return req.ProjectId == "" || e.ProjectId == req.ProjectId
+	})
+	exports, next, err := pageNext(exports, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &lgv1.ListExportResponse{Exports: exports, NextPage: next}, nil
+}
+
+func (c *logsExportService) Create(ctx context.Context, req *lgv1.CreateExportRequest) (*dcv1.Operation, error) {
+	if err := required("name", req.Name); err != nil {
+		return nil, err
+	}
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	export := &lgv1.LogsExport{
+		Id:          c.s.newID(logsExportPrefix),
+		ProjectId:   projectOrDefault(req.ProjectId),
+		Name:        req.Name,
+		Description: req.Description,
+		Sources:     req.Sources,
+		Target:      req.Target,
+		Status:      lgv1.LogExportStatus_LOG_EXPORT_STATUS_PENDING,
+	}
+	c.s.exports[export.Id] = export
+	return c.s.startOperation(serviceLogs, export.Id, "Create logs export", func() error {
+		export, err := c.s.logsExport(export.Id)
+		if err != nil {
+			return err
+		}
+		export.Status = lgv1.LogExportStatus_LOG_EXPORT_STATUS_RUNNING
+		return nil
+	}), nil
+}
+
+func (c *logsExportService) Update(ctx context.Context, req *lgv1.UpdateExportRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.logsExport(req.Id); err != nil {
+		return nil, err
+	}
+	update := clone(req)
+	return c.s.startOperation(serviceLogs, update.Id, "Update logs export", func() error {
+		export, err := c.s.logsExport(update.Id)
+		if err != nil {
+			return err
+		}
+		if update.Name != "" {
+			export.Name = update.Name
+		}
+		if update.Description != "" {
+			export.Description = update.Description
+		}
+		if update.Sources != nil {
+			export.Sources = update.Sources
+		}
+		if update.Target != nil {
+			export.Target = update.Target
+		}
+		return nil
+	}), nil
+}
+
+func (c *logsExportService) Delete(ctx context.Context, req *lgv1.DeleteExportRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.logsExport(req.Id); err != nil {
+		return nil, err
+	}
+	id := req.Id
+	return c.s.startOperation(serviceLogs, id, "Delete logs export", func() error {
+		delete(c.s.exports, id)
+		return nil
+	}), nil
+}
+
+type logsOperationService struct {
+	lgv1.UnimplementedOperationServiceServer
+	s *Server
+}
+
+func (c *logsOperationService) Get(ctx context.Context, req *lgv1.GetOperationRequest) (*dcv1.Operation, error) {
+	return c.s.pollOperation(ctx, serviceLogs, req.OperationId)
+}
+
+// logsExport must be called with mu held.
+func (s *Server) logsExport(id string) (*lgv1.LogsExport, error) {
+	export, ok := s.exports[id]
+	if !ok {
+		return nil, notFound("logs export", id)
+	}
+	return export, nil
+}
@@ -0,0 +1,36 @@
+package dctest
+
+import (
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// rescheduledMaintenance returns planned maintenance moved as requested, or error the real API responds with.
+func rescheduledMaintenance(clusterID string, planned *dcv1.MaintenanceOperation, rescheduleType dcv1.RescheduleType, until *timestamppb.Timestamp) (*dcv1.MaintenanceOperation, error) {
+	if planned.GetScheduledMaintenanceTime() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q has no planned maintenance", clusterID)
+	}
+	result := clone(planned)
+	switch rescheduleType {
+	case dcv1.RescheduleType_RESCHEDULE_TYPE_IMMEDIATE:
+		result.ScheduledMaintenanceTime = timestamppb.Now()
+	case dcv1.RescheduleType_RESCHEDULE_TYPE_NEXT_AVAILABLE_WINDOW:
+		if planned.NextMaintenanceWindowTime == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "cluster %q has no next maintenance window", clusterID)
+		}
+		result.ScheduledMaintenanceTime = planned.NextMaintenanceWindowTime
+	case dcv1.RescheduleType_RESCHEDULE_TYPE_SPECIFIC_TIME:
+		if until == nil {
+			return nil, status.Error(codes.InvalidArgument, "delayed_until_time is required")
+		}
+		if deadline := planned.DeadlineMaintenanceTime; deadline != nil && until.AsTime().After(deadline.AsTime()) {
+			return nil, status.Errorf(codes.InvalidArgument, "delayed_until_time is after maintenance deadline %s", deadline.AsTime())
+		}
+		result.ScheduledMaintenanceTime = until
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown reschedule type %s", rescheduleType)
+	}
+	return result, nil
+}
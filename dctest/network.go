@@ -0,0 +1,120 @@
+package dctest
+
+import (
+	"context"
+
+	nwv1 "github.com/doublecloud/go-genproto/doublecloud/network/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const networkPrefix = "vpc"
+
+func (s *Server) registerNetwork() {
+	nwv1.RegisterNetworkServiceServer(s.grpc, &networkService{s: s})
+	nwv1.RegisterOperationServiceServer(s.grpc, &networkOperationService{s: s})
+}
+
+type networkService struct {
+	nwv1.UnimplementedNetworkServiceServer
+	s *Server
+}
+
+func (c *networkService) Get(ctx context.Context, req *nwv1.GetNetworkRequest) (*nwv1.Network, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	network, err := c.s.network(req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	return clone(network), nil
+}
+
+func (c *networkService) List(ctx context.Context, req *nwv1.ListNetworksRequest) (*nwv1.ListNetworksResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	networks := listSorted(c.s.networks, func(n *nwv1.Network) bool {
+		return req.ProjectId == "" || n.ProjectId == req.ProjectId
+	})
+	networks, next, err := pageNext(networks, req.Paging)
+	if err != nil {
+		return nil, err
+	}
+	return &nwv1.ListNetworksResponse{Networks: networks, NextPage: next}, nil
+}
+
+func (c *networkService) Create(ctx context.Context, req *nwv1.CreateNetworkRequest) (*dcv1.Operation, error) {
+	if err := required("name", req.Name); err != nil {
+		return nil, err
+	}
+	if err := required("ipv4_cidr_block", req.Ipv4CidrBlock); err != nil {
+		return nil, err
+	}
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	network := &nwv1.Network{
+		Id:            c.s.newID(networkPrefix),
+		ProjectId:     projectOrDefault(req.ProjectId),
+		CloudType:     req.CloudType,
+		RegionId:      req.RegionId,
+		CreateTime:    timestamppb.Now(),
+		Name:          req.Name,
+		Description:   req.Description,
+		Ipv4CidrBlock: req.Ipv4CidrBlock,
+		Status:        nwv1.Network_NETWORK_STATUS_CREATING,
+	}
+	c.s.networks[network.Id] = network
+	return c.s.startOperation(serviceNetwork, network.Id, "Create network", func() error {
+		network, err := c.s.network(network.Id)
+		if err != nil {
+			return err
+		}
+		network.Status = nwv1.Network_NETWORK_STATUS_ACTIVE
+		return nil
+	}), nil
+}
+
+func (c *networkService) Delete(ctx context.Context, req *nwv1.DeleteNetworkRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	network, err := c.s.network(req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range c.s.chClusters {
+		if cluster.NetworkId == network.Id {
+			return nil, status.Errorf(codes.FailedPrecondition, "network %q is used by cluster %q", network.Id, cluster.Id)
+		}
+	}
+	for _, cluster := range c.s.kfClusters {
+		if cluster.NetworkId == network.Id {
+			return nil, status.Errorf(codes.FailedPrecondition, "network %q is used by cluster %q", network.Id, cluster.Id)
+		}
+	}
+	network.Status = nwv1.Network_NETWORK_STATUS_DELETING
+	id := network.Id
+	return c.s.startOperation(serviceNetwork, id, "Delete network", func() error {
+		delete(c.s.networks, id)
+		return nil
+	}), nil
+}
+
+type networkOperationService struct {
+	nwv1.UnimplementedOperationServiceServer
+	s *Server
+}
+
+func (c *networkOperationService) Get(ctx context.Context, req *nwv1.GetOperationRequest) (*dcv1.Operation, error) {
+	return c.s.pollOperation(ctx, serviceNetwork, req.OperationId)
+}
+
+// network must be called with mu held.
+func (s *Server) network(id string) (*nwv1.Network, error) {
+	network, ok := s.networks[id]
+	if !ok {
+		return nil, notFound("network", id)
+	}
+	return network, nil
+}
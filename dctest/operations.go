@@ -0,0 +1,188 @@
+package dctest
+
+import (
+	"context"
+	"sort"
+
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"github.com/doublecloud/go-sdk/operation"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Services operations belong to. Operation is found only by OperationService of its service.
+const (
+	serviceClickHouse    = "clickhouse"
+	serviceKafka         = "kafka"
+	serviceTransfer      = "transfer"
+	serviceNetwork       = "network"
+	serviceLogs          = "logs"
+	serviceVisualization = "visualization"
+)
+
+const (
+	logsOperationPrefix          = "lgo"
+	visualizationOperationPrefix = "vzo"
+	// pollIntervalMetadataKey makes operation.Wait poll the fake without delays.
+	pollIntervalMetadataKey = "x-operation-poll-interval"
+)
+
+type fakeOperation struct {
+	service string
+	// seq orders operations by creation, network operation IDs are random UUIDs.
+	seq   int
+	proto *dcv1.Operation
+	polls int
+	// apply makes the change of the operation when it's done. Returned error fails the operation.
+	apply func() error
+
+	// Injected faults, see OperationFault.
+	extraPolls    int
+	notFoundPolls int
+	err           *status.Status
+}
+
+// startOperation registers new PENDING operation on resourceID. Must be called with mu held.
+func (s *Server) startOperation(service, resourceID, description string, apply func() error) *dcv1.Operation {
+	return clone(s.newOperation(service, resourceID, description, apply).proto)
+}
+
+// doneOperation registers operation that is done immediately, for services without OperationService.
+// Must be called with mu held.
+func (s *Server) doneOperation(service, resourceID, description string, apply func() error) *dcv1.Operation {
+	op := s.newOperation(service, resourceID, description, apply)
+	s.finishOperation(op)
+	return clone(op.proto)
+}
+
+func (s *Server) newOperation(service, resourceID, description string, apply func() error) *fakeOperation {
+	var id string
+	switch service {
+	case serviceClickHouse:
+		id = s.newID(operation.CLICKHOUSE_OPERATION_PREFIX)
+	case serviceKafka:
+		id = s.newID(operation.KAFKA_OPERATION_PREFIX)
+	case serviceTransfer:
+		id = s.newID(operation.TRANSFER_OPERATION_PREFIX)
+	case serviceNetwork:
+		s.seq++
+		id = uuid.NewString()
+	case serviceLogs:
+		id = s.newID(logsOperationPrefix)
+	default:
+		id = s.newID(visualizationOperationPrefix)
+	}
+	op := &fakeOperation{
+		service: service,
+		seq:     s.seq,
+		proto: &dcv1.Operation{
+			Id:          id,
+			ProjectId:   DefaultProjectID,
+			Description: description,
+			CreatedBy:   "dctest",
+			CreateTime:  timestamppb.Now(),
+			Status:      dcv1.Operation_STATUS_PENDING,
+			ResourceId:  resourceID,
+		},
+		apply:         apply,
+		notFoundPolls: s.cfg.OperationNotFoundPolls,
+	}
+	s.operations[id] = op
+	return op
+}
+
+// setOperationMetadata sets metadata key of the operation and its copy op. Must be called with mu held.
+func (s *Server) setOperationMetadata(op *dcv1.Operation, key, value string) *dcv1.Operation {
+	stored := s.operations[op.Id].proto
+	if stored.Metadata == nil {
+		stored.Metadata = map[string]string{}
+	}
+	stored.Metadata[key] = value
+	if op.Metadata == nil {
+		op.Metadata = map[string]string{}
+	}
+	op.Metadata[key] = value
+	return op
+}
+
+// pollOperation returns operation state, progressing it on every poll.
+func (s *Server) pollOperation(ctx context.Context, service, id string) (*dcv1.Operation, error) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(pollIntervalMetadataKey, "0"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.operations[id]
+	if !ok || op.service != service {
+		return nil, notFound("operation", id)
+	}
+	if op.notFoundPolls > 0 {
+		op.notFoundPolls--
+		return nil, notFound("operation", id)
+	}
+	if op.proto.Status != dcv1.Operation_STATUS_DONE {
+		op.polls++
+		if op.polls >= s.cfg.OperationPolls+op.extraPolls {
+			s.finishOperation(op)
+		} else {
+			op.proto.Status = dcv1.Operation_STATUS_RUNNING
+			if op.proto.StartTime == nil {
+				op.proto.StartTime = timestamppb.Now()
+			}
+		}
+	}
+	return clone(op.proto), nil
+}
+
+// finishOperation applies the operation change and marks it done. Must be called with mu held.
+func (s *Server) finishOperation(op *fakeOperation) {
+	if op.proto.Status == dcv1.Operation_STATUS_DONE {
+		return
+	}
+	if op.err != nil {
+		op.proto.Error = op.err.Proto()
+	} else if op.apply != nil {
+		if err := op.apply(); err != nil {
+			op.proto.Error = status.Convert(err).Proto()
+		}
+	}
+	now := timestamppb.Now()
+	if op.proto.StartTime == nil {
+		op.proto.StartTime = now
+	}
+	op.proto.FinishTime = now
+	op.proto.Status = dcv1.Operation_STATUS_DONE
+}
+
+// CompleteOperations makes all pending operations done in order of creation, as if they were polled.
+func (s *Server) CompleteOperations() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, op := range s.sortedOperations() {
+		s.finishOperation(op)
+	}
+}
+
+// resourceOperations lists operations on resourceID ordered by creation. Must be called with mu held.
+func (s *Server) resourceOperations(service, resourceID string) []*dcv1.Operation {
+	var ops []*dcv1.Operation
+	for _, op := range s.sortedOperations() {
+		if op.service == service && op.proto.ResourceId == resourceID {
+			ops = append(ops, clone(op.proto))
+		}
+	}
+	return ops
+}
+
+func (s *Server) sortedOperations() []*fakeOperation {
+	ops := make([]*fakeOperation, 0, len(s.operations))
+	for _, op := range s.operations {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].seq < ops[j].seq
+	})
+	return ops
+}
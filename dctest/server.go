@@ -0,0 +1,232 @@
+// Package dctest provides in-process fake of DoubleCloud API for tests of code that uses dcsdk.SDK.
+//
+// Server keeps resources in memory and serves ClickHouse, Kafka, Transfer, Network, Logs and Visualization
+// services over bufconn, so SDK calls go through the real gRPC client stack without network access:
+//
+//	srv := dctest.NewServer(dctest.Config{})
+//	defer srv.Close()
+//	sdk, err := srv.SDK(ctx)
+//	op, err := sdk.WrapOperation(sdk.ClickHouse().Cluster().Create(ctx, req))
+//	err = op.Wait(ctx)
+//
+// Mutating calls return operations in PENDING status that are done after Config.OperationPolls polls,
+// the change is applied to resources when operation is done. Only commonly used methods are implemented,
+// the rest respond with UNIMPLEMENTED status.
+//
+// Faults can be injected into calls and operations to test retries and waiting deterministically,
+// see Server.InjectFault, Server.InjectOperationFault, Server.ExpireTokens and Server.DropConnections.
+// TokenServer fakes IAM token service for tests of authorization with service account keys.
+package dctest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	lgv1 "github.com/doublecloud/go-genproto/doublecloud/logs/v1"
+	nwv1 "github.com/doublecloud/go-genproto/doublecloud/network/v1"
+	trv1 "github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	vzv1 "github.com/doublecloud/go-genproto/doublecloud/visualization/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultProjectID is used for resources created without project ID.
+	DefaultProjectID = "fake-project"
+
+	bufSize  = 1 << 20
+	endpoint = "dctest.bufconn"
+)
+
+// Config configures Server.
+type Config struct {
+	// OperationPolls is how many times operation must be polled to be done.
+	// Operation is RUNNING after the first poll, if it's not done yet.
+	// Default value: 1
+	OperationPolls int
+	// OperationNotFoundPolls is how many first polls of every operation respond with NOT_FOUND,
+	// as if operation was not replicated yet. See also InjectOperationFault.
+	OperationNotFoundPolls int
+	// ClickHouseVersions are listed by ClickHouse VersionService.
+	// Default value: DefaultClickHouseVersions
+	ClickHouseVersions []*chv1.Version
+	// TokenServer makes the server accept calls with IAM tokens issued by it only.
+	// By default calls without IAM token are accepted.
+	TokenServer *TokenServer
+	// ServerOptions are appended to options of the gRPC server, e.g. interceptors.
+	ServerOptions []grpc.ServerOption
+}
+
+// Server is in-memory fake of DoubleCloud API. It is safe for concurrent use.
+type Server struct {
+	cfg  Config
+	lis  *listener
+	grpc *grpc.Server
+
+	// mu guards all the state below
+	mu         sync.Mutex
+	seq        int
+	operations map[string]*fakeOperation
+	faults     []*injectedFault
+	opFaults   []*injectedOperationFault
+	// tokens maps IAM tokens seen by the server to whether they are expired.
+	tokens map[string]bool
+	calls  map[string]int
+
+	chClusters map[string]*chv1.Cluster
+	chBackups  map[string]*chv1.Backup
+	kfClusters map[string]*kfv1.Cluster
+	transfers  map[string]*trv1.Transfer
+	endpoints  map[string]*trv1.Endpoint
+	networks   map[string]*nwv1.Network
+	exports    map[string]*lgv1.LogsExport
+	workbooks  map[string]*vzv1.GetWorkbookResponse
+}
+
+// NewServer starts fake API server. Close it after use.
+func NewServer(cfg Config) *Server {
+	if cfg.OperationPolls <= 0 {
+		cfg.OperationPolls = 1
+	}
+	s := &Server{
+		cfg:        cfg,
+		lis:        newListener(),
+		operations: map[string]*fakeOperation{},
+		tokens:     map[string]bool{},
+		calls:      map[string]int{},
+		chClusters: map[string]*chv1.Cluster{},
+		chBackups:  map[string]*chv1.Backup{},
+		kfClusters: map[string]*kfv1.Cluster{},
+		transfers:  map[string]*trv1.Transfer{},
+		endpoints:  map[string]*trv1.Endpoint{},
+		networks:   map[string]*nwv1.Network{},
+		exports:    map[string]*lgv1.LogsExport{},
+		workbooks:  map[string]*vzv1.GetWorkbookResponse{},
+	}
+	s.grpc = grpc.NewServer(append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.unaryInterceptor),
+		grpc.ChainStreamInterceptor(s.streamInterceptor),
+	}, cfg.ServerOptions...)...)
+	s.registerClickHouse()
+	s.registerKafka()
+	s.registerTransfer()
+	s.registerNetwork()
+	s.registerLogs()
+	s.registerVisualization()
+	go func() {
+		_ = s.grpc.Serve(s.lis)
+	}()
+	return s
+}
+
+// Close stops the server and drops all connections.
+func (s *Server) Close() {
+	s.grpc.Stop()
+}
+
+// DialOption makes gRPC client connect to the server regardless of the target address.
+func (s *Server) DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(s.lis.dial)
+}
+
+// Config returns SDK config for the server with NoCredentials.
+func (s *Server) Config() dcsdk.Config {
+	return dcsdk.Config{
+		Credentials:      dcsdk.NoCredentials{},
+		Endpoint:         endpoint,
+		OverrideEndpoint: true,
+		Plaintext:        true,
+	}
+}
+
+// SDK builds SDK connected to the server.
+func (s *Server) SDK(ctx context.Context) (*dcsdk.SDK, error) {
+	return dcsdk.Build(ctx, s.Config(), s.DialOption())
+}
+
+// Conn dials the server directly, for tests of code that uses genproto clients without SDK.
+func (s *Server) Conn(ctx context.Context) (*grpc.ClientConn, error) {
+	return grpc.DialContext(ctx, endpoint, s.DialOption(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// newID generates resource ID with prefix, IDs are ordered by creation. Must be called with mu held.
+func (s *Server) newID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s%017d", prefix, s.seq)
+}
+
+func notFound(kind, id string) error {
+	return status.Errorf(codes.NotFound, "%s %q not found", kind, id)
+}
+
+func required(field, value string) error {
+	if value == "" {
+		return status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	return nil
+}
+
+func projectOrDefault(projectID string) string {
+	if projectID == "" {
+		return DefaultProjectID
+	}
+	return projectID
+}
+
+// listSorted returns clones of resources that match keep, ordered by ID, i.e. by creation.
+func listSorted[T proto.Message](resources map[string]T, keep func(T) bool) []T {
+	ids := make([]string, 0, len(resources))
+	for id, r := range resources {
+		if keep == nil || keep(r) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	result := make([]T, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, proto.Clone(resources[id]).(T))
+	}
+	return result
+}
+
+func clone[T proto.Message](msg T) T {
+	return proto.Clone(msg).(T)
+}
+
+// page cuts a page from items. Page token is an offset of the page start.
+func page[T any](items []T, pageSize int64, pageToken string) ([]T, string, error) {
+	offset := 0
+	if pageToken != "" {
+		var err error
+		offset, err = strconv.Atoi(pageToken)
+		if err != nil || offset < 0 || offset > len(items) {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid page token %q", pageToken)
+		}
+	}
+	items = items[offset:]
+	if pageSize <= 0 || int(pageSize) >= len(items) {
+		return items, "", nil
+	}
+	return items[:pageSize], strconv.Itoa(offset + int(pageSize)), nil
+}
+
+func pageNext[T any](items []T, paging *dcv1.Paging) ([]T, *dcv1.NextPage, error) {
+	items, next, err := page(items, paging.GetPageSize(), paging.GetPageToken())
+	if err != nil {
+		return nil, nil, err
+	}
+	if next == "" {
+		return items, nil, nil
+	}
+	return items, &dcv1.NextPage{Token: next}, nil
+}
@@ -0,0 +1,163 @@
+package dctest
+
+import (
+	"context"
+	"testing"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	nwv1 "github.com/doublecloud/go-genproto/doublecloud/network/v1"
+	trv1 "github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	vzv1 "github.com/doublecloud/go-genproto/doublecloud/visualization/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newSDK(t *testing.T, cfg Config) (*Server, *dcsdk.SDK) {
+	srv := NewServer(cfg)
+	t.Cleanup(srv.Close)
+	sdk, err := srv.SDK(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(context.Background()) })
+	return srv, sdk
+}
+
+func TestServer_ClickHouseClusterLifecycle(t *testing.T) {
+	ctx := context.Background()
+	_, sdk := newSDK(t, Config{OperationPolls: 2})
+	clusters := sdk.ClickHouse().Cluster()
+
+	op, err := sdk.WrapOperation(clusters.Create(ctx, &chv1.CreateClusterRequest{ProjectId: "p1", Name: "ch"}))
+	require.NoError(t, err)
+	assert.Equal(t, dcv1.Operation_STATUS_PENDING, op.Proto().Status)
+
+	cluster, err := clusters.Get(ctx, &chv1.GetClusterRequest{ClusterId: op.ResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, dcv1.ClusterStatus_CLUSTER_STATUS_CREATING, cluster.Status)
+
+	require.NoError(t, op.Poll(ctx))
+	assert.Equal(t, dcv1.Operation_STATUS_RUNNING, op.Proto().Status)
+	require.NoError(t, op.Wait(ctx))
+	assert.True(t, op.Ok())
+
+	cluster, err = clusters.Get(ctx, &chv1.GetClusterRequest{ClusterId: op.ResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE, cluster.Status)
+	assert.Equal(t, "ch", cluster.Name)
+	assert.NotEmpty(t, cluster.ConnectionInfo.GetHost())
+
+	op, err = sdk.WrapOperation(sdk.ClickHouse().Backup().Create(ctx, &chv1.CreateBackupRequest{ClusterId: cluster.Id}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	backups, err := clusters.ListBackups(ctx, &chv1.ListClusterBackupsRequest{ClusterId: cluster.Id})
+	require.NoError(t, err)
+	require.Len(t, backups.Backups, 1)
+	assert.Equal(t, op.Metadata()["backup_id"], backups.Backups[0].Id)
+
+	list, err := clusters.List(ctx, &chv1.ListClustersRequest{ProjectId: "p1"})
+	require.NoError(t, err)
+	assert.Len(t, list.Clusters, 1)
+
+	op, err = sdk.WrapOperation(clusters.Delete(ctx, &chv1.DeleteClusterRequest{ClusterId: cluster.Id}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	_, err = clusters.Get(ctx, &chv1.GetClusterRequest{ClusterId: cluster.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_KafkaStopStart(t *testing.T) {
+	ctx := context.Background()
+	_, sdk := newSDK(t, Config{})
+	clusters := sdk.Kafka().Cluster()
+
+	op, err := sdk.WrapOperation(clusters.Create(ctx, &kfv1.CreateClusterRequest{Name: "kf"}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	id := op.ResourceId()
+
+	_, err = clusters.Start(ctx, &kfv1.StartClusterRequest{ClusterId: id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	op, err = sdk.WrapOperation(clusters.Stop(ctx, &kfv1.StopClusterRequest{ClusterId: id}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	cluster, err := clusters.Get(ctx, &kfv1.GetClusterRequest{ClusterId: id})
+	require.NoError(t, err)
+	assert.Equal(t, dcv1.ClusterStatus_CLUSTER_STATUS_STOPPED, cluster.Status)
+
+	ops, err := clusters.ListOperations(ctx, &kfv1.ListClusterOperationsRequest{ClusterId: id})
+	require.NoError(t, err)
+	assert.Len(t, ops.Operations, 2)
+}
+
+func TestServer_TransferAndNetwork(t *testing.T) {
+	ctx := context.Background()
+	srv, sdk := newSDK(t, Config{})
+
+	var endpointIDs []string
+	for _, name := range []string{"source", "target"} {
+		op, err := sdk.WrapOperation(sdk.Transfer().Endpoint().Create(ctx, &trv1.CreateEndpointRequest{Name: name}))
+		require.NoError(t, err)
+		require.NoError(t, op.Wait(ctx))
+		endpointIDs = append(endpointIDs, op.ResourceId())
+	}
+	op, err := sdk.WrapOperation(sdk.Transfer().Transfer().Create(ctx, &trv1.CreateTransferRequest{
+		Name: "transfer", SourceId: endpointIDs[0], TargetId: endpointIDs[1],
+	}))
+	require.NoError(t, err)
+	srv.CompleteOperations()
+	transfer, err := sdk.Transfer().Transfer().Get(ctx, &trv1.GetTransferRequest{TransferId: op.ResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, trv1.TransferStatus_CREATED, transfer.Status)
+	assert.Equal(t, "source", transfer.Source.Name)
+
+	op, err = sdk.WrapOperation(sdk.Network().Network().Create(ctx, &nwv1.CreateNetworkRequest{Name: "net", Ipv4CidrBlock: "10.0.0.0/16"}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	network, err := sdk.Network().Network().Get(ctx, &nwv1.GetNetworkRequest{NetworkId: op.ResourceId()})
+	require.NoError(t, err)
+	assert.Equal(t, nwv1.Network_NETWORK_STATUS_ACTIVE, network.Status)
+}
+
+func TestServer_Visualization(t *testing.T) {
+	ctx := context.Background()
+	_, sdk := newSDK(t, Config{})
+	workbooks := sdk.Visualization().Workbook()
+
+	op, err := workbooks.Create(ctx, &vzv1.CreateWorkbookRequest{WorkbookTitle: "dashboards"})
+	require.NoError(t, err)
+	assert.Equal(t, dcv1.Operation_STATUS_DONE, op.Status)
+
+	workbook, err := workbooks.Get(ctx, &vzv1.GetWorkbookRequest{WorkbookId: op.ResourceId})
+	require.NoError(t, err)
+	assert.Equal(t, "dashboards", workbook.Title)
+}
+
+func TestServer_Paging(t *testing.T) {
+	ctx := context.Background()
+	srv, sdk := newSDK(t, Config{})
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := sdk.ClickHouse().Cluster().Create(ctx, &chv1.CreateClusterRequest{Name: name})
+		require.NoError(t, err)
+	}
+	srv.CompleteOperations()
+
+	var names []string
+	req := &chv1.ListClustersRequest{Paging: &dcv1.Paging{PageSize: 2}}
+	for {
+		resp, err := sdk.ClickHouse().Cluster().List(ctx, req)
+		require.NoError(t, err)
+		for _, c := range resp.Clusters {
+			names = append(names, c.Name)
+		}
+		if resp.NextPage == nil {
+			break
+		}
+		req.Paging.PageToken = resp.NextPage.Token
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+}
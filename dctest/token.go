@@ -0,0 +1,381 @@
+package dctest
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultTokenTTL is lifetime of IAM tokens issued by TokenServer by default.
+	DefaultTokenTTL = 12 * time.Hour
+
+	jwtBearerGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	tokenEndpoint      = "dctest-iam.bufconn"
+)
+
+// TokenServerConfig configures TokenServer.
+type TokenServerConfig struct {
+	// TokenTTL is lifetime of issued IAM tokens. Default value: DefaultTokenTTL
+	TokenTTL time.Duration
+	// Audience is expected audience of JWT assertions. Default value: TokenServer.URL()
+	Audience string
+}
+
+// IssuedToken is IAM token issued by TokenServer.
+type IssuedToken struct {
+	Token            string
+	ServiceAccountID string
+	ExpiresAt        time.Time
+}
+
+// TokenServer is fake of DoubleCloud IAM token service. It exchanges PS256 JWT assertions signed by
+// service account keys for IAM tokens over OAuth HTTP endpoint and over gRPC IamTokenService.
+//
+// JWT assertions are checked against public keys added to the server: kid header must be a known key ID,
+// iss claim must be the key service account ID, aud claim must be the expected audience.
+// Service account credentials sign assertions for DOUBLE_CLOUD_TOKEN_URL env audience,
+// so set the env to URL() in tests:
+//
+//	ts := dctest.NewTokenServer(dctest.TokenServerConfig{})
+//	defer ts.Close()
+//	t.Setenv("DOUBLE_CLOUD_TOKEN_URL", ts.URL())
+//	key, err := ts.NewServiceAccountKey("sa-1")
+//	creds, err := dcsdk.ServiceAccountKey(key)
+//
+// Tokens issued by the server are accepted by Server configured with it, see Config.TokenServer.
+type TokenServer struct {
+	cfg  TokenServerConfig
+	http *httptest.Server
+	lis  *listener
+	grpc *grpc.Server
+
+	// mu guards all the state below
+	mu          sync.Mutex
+	seq         int
+	keys        map[string]serviceAccountKey
+	tokens      map[string]*IssuedToken
+	delegations map[string]map[string]bool
+}
+
+type serviceAccountKey struct {
+	serviceAccountID string
+	publicKey        *rsa.PublicKey
+}
+
+// NewTokenServer starts fake IAM token service. Close it after use.
+func NewTokenServer(cfg TokenServerConfig) *TokenServer {
+	if cfg.TokenTTL <= 0 {
+		cfg.TokenTTL = DefaultTokenTTL
+	}
+	ts := &TokenServer{
+		cfg:         cfg,
+		lis:         newListener(),
+		grpc:        grpc.NewServer(),
+		keys:        map[string]serviceAccountKey{},
+		tokens:      map[string]*IssuedToken{},
+		delegations: map[string]map[string]bool{},
+	}
+	ts.http = httptest.NewServer(http.HandlerFunc(ts.serveToken))
+	iamkey.RegisterIamTokenServiceServer(ts.grpc, &iamTokenService{ts: ts})
+	go func() {
+		_ = ts.grpc.Serve(ts.lis)
+	}()
+	return ts
+}
+
+// Close stops the server.
+func (ts *TokenServer) Close() {
+	ts.http.Close()
+	ts.grpc.Stop()
+}
+
+// URL returns OAuth token endpoint URL.
+func (ts *TokenServer) URL() string {
+	return ts.http.URL + "/oauth/token"
+}
+
+// Exchanger returns token exchanger for SDK Config.TokenExchanger that uses the OAuth endpoint.
+func (ts *TokenServer) Exchanger() *dcsdk.HTTPTokenExchanger {
+	return &dcsdk.HTTPTokenExchanger{TokenURL: ts.URL()}
+}
+
+// DialOption makes gRPC client connect to IamTokenService of the server regardless of the target address.
+func (ts *TokenServer) DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(ts.lis.dial)
+}
+
+// Conn dials IamTokenService of the server.
+func (ts *TokenServer) Conn(ctx context.Context) (*grpc.ClientConn, error) {
+	return grpc.DialContext(ctx, tokenEndpoint, ts.DialOption(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// AddKey registers public key keyID of the service account, so JWT assertions signed by the key are accepted.
+func (ts *TokenServer) AddKey(keyID, serviceAccountID string, publicKey *rsa.PublicKey) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.keys[keyID] = serviceAccountKey{serviceAccountID: serviceAccountID, publicKey: publicKey}
+}
+
+// AddServiceAccountKey registers public key of the service account key.
+func (ts *TokenServer) AddServiceAccountKey(key *iamkey.Key) error {
+	if key.Id == "" || key.GetServiceAccountId() == "" {
+		return errors.New("key id and service account id are required")
+	}
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(key.PublicKey))
+	if err != nil {
+		return sdkerrors.WithMessage(err, "public key parse failed")
+	}
+	ts.AddKey(key.Id, key.GetServiceAccountId(), publicKey)
+	return nil
+}
+
+// NewServiceAccountKey generates key of the service account and registers its public key.
+func (ts *TokenServer) NewServiceAccountKey(serviceAccountID string) (*iamkey.Key, error) {
+	key, err := iamkey.Generate(serviceAccountID, iamkey.Key_RSA_2048)
+	if err != nil {
+		return nil, err
+	}
+	ts.mu.Lock()
+	ts.seq++
+	key.Id = fmt.Sprintf("dctestkey%011d", ts.seq)
+	ts.mu.Unlock()
+	return key, ts.AddServiceAccountKey(key)
+}
+
+// RemoveKey makes JWT assertions signed by key keyID rejected. Tokens issued before stay valid.
+func (ts *TokenServer) RemoveKey(keyID string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	delete(ts.keys, keyID)
+}
+
+// AllowDelegation lets caller service account get IAM tokens of target service account
+// with IamTokenService.CreateForServiceAccount.
+func (ts *TokenServer) AllowDelegation(callerServiceAccountID, targetServiceAccountID string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.delegations[callerServiceAccountID] == nil {
+		ts.delegations[callerServiceAccountID] = map[string]bool{}
+	}
+	ts.delegations[callerServiceAccountID][targetServiceAccountID] = true
+}
+
+// Issued returns all tokens issued by the server in order of issue.
+func (ts *TokenServer) Issued() []IssuedToken {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	issued := make([]IssuedToken, 0, len(ts.tokens))
+	for _, t := range ts.tokens {
+		issued = append(issued, *t)
+	}
+	sort.Slice(issued, func(i, j int) bool {
+		return issued[i].Token < issued[j].Token
+	})
+	return issued
+}
+
+// ExpireTokens makes all tokens issued so far expired.
+func (ts *TokenServer) ExpireTokens() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	now := time.Now()
+	for _, t := range ts.tokens {
+		if t.ExpiresAt.After(now) {
+			t.ExpiresAt = now
+		}
+	}
+}
+
+// ServiceAccount returns ID of service account the IAM token was issued for.
+// Returns UNAUTHENTICATED error for unknown and expired tokens.
+func (ts *TokenServer) ServiceAccount(iamToken string) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	t, ok := ts.tokens[iamToken]
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "unknown iam token")
+	}
+	if !time.Now().Before(t.ExpiresAt) {
+		return "", status.Error(codes.Unauthenticated, "iam token expired")
+	}
+	return t.ServiceAccountID, nil
+}
+
+// exchangeJWT validates JWT assertion and issues IAM token for its service account.
+func (ts *TokenServer) exchangeJWT(assertion string) (*IssuedToken, error) {
+	var key serviceAccountKey
+	claims := &jwt.RegisteredClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodPS256.Alg()}))
+	_, err := parser.ParseWithClaims(assertion, claims, func(token *jwt.Token) (interface{}, error) {
+		keyID, _ := token.Header["kid"].(string)
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		var ok bool
+		key, ok = ts.keys[keyID]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", keyID)
+		}
+		return key.publicKey, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "invalid assertion")
+	}
+	if claims.ExpiresAt == nil {
+		return nil, errors.New("invalid assertion: exp claim is required")
+	}
+	if claims.Issuer != key.serviceAccountID {
+		return nil, fmt.Errorf("invalid assertion: issuer %q does not own the key", claims.Issuer)
+	}
+	audience := ts.cfg.Audience
+	if audience == "" {
+		audience = ts.URL()
+	}
+	if !claims.VerifyAudience(audience, true) {
+		return nil, fmt.Errorf("invalid assertion: audience %q expected, got %q", audience, claims.Audience)
+	}
+	return ts.issue(key.serviceAccountID), nil
+}
+
+// delegate issues IAM token of target service account for the caller with callerToken.
+func (ts *TokenServer) delegate(callerToken, serviceAccountID string) (*IssuedToken, error) {
+	caller, err := ts.ServiceAccount(callerToken)
+	if err != nil {
+		return nil, err
+	}
+	ts.mu.Lock()
+	allowed := ts.delegations[caller][serviceAccountID]
+	ts.mu.Unlock()
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "service account %q can not act as %q", caller, serviceAccountID)
+	}
+	return ts.issue(serviceAccountID), nil
+}
+
+func (ts *TokenServer) issue(serviceAccountID string) *IssuedToken {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.seq++
+	t := &IssuedToken{
+		Token:            fmt.Sprintf("dctest.%011d.%s", ts.seq, serviceAccountID),
+		ServiceAccountID: serviceAccountID,
+		// Token endpoint responds with expires_in in seconds.
+		ExpiresAt: time.Now().Add(ts.cfg.TokenTTL).Truncate(time.Second),
+	}
+	ts.tokens[t.Token] = t
+	copied := *t
+	return &copied
+}
+
+func (ts *TokenServer) serveToken(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/oauth/token" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeOAuthError(w, http.StatusMethodNotAllowed, "invalid_request", "POST expected")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	if grantType := r.PostForm.Get("grant_type"); grantType != jwtBearerGrantType {
+		writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", fmt.Sprintf("grant type %q is not supported", grantType))
+		return
+	}
+	assertion := r.PostForm.Get("assertion")
+	if assertion == "" {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "assertion is required")
+		return
+	}
+	token, err := ts.exchangeJWT(assertion)
+	if err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_grant", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{
+		AccessToken: token.Token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(time.Until(token.ExpiresAt).Round(time.Second) / time.Second),
+	})
+}
+
+func writeOAuthError(w http.ResponseWriter, statusCode int, code, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(struct {
+		Code        string `json:"error"`
+		Description string `json:"error_description"`
+	}{code, description})
+}
+
+type iamTokenService struct {
+	iamkey.UnimplementedIamTokenServiceServer
+	ts *TokenServer
+}
+
+func (c *iamTokenService) Create(ctx context.Context, req *iamkey.CreateIamTokenRequest) (*iamkey.CreateIamTokenResponse, error) {
+	if err := required("jwt", req.GetJwt()); err != nil {
+		return nil, err
+	}
+	token, err := c.ts.exchangeJWT(req.GetJwt())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return tokenResponse(token), nil
+}
+
+func (c *iamTokenService) CreateForServiceAccount(ctx context.Context, req *iamkey.CreateIamTokenForServiceAccountRequest) (*iamkey.CreateIamTokenResponse, error) {
+	if err := required("service_account_id", req.ServiceAccountId); err != nil {
+		return nil, err
+	}
+	callerToken := bearerToken(ctx)
+	if callerToken == "" {
+		return nil, status.Error(codes.Unauthenticated, "authorization required")
+	}
+	token, err := c.ts.delegate(callerToken, req.ServiceAccountId)
+	if err != nil {
+		return nil, err
+	}
+	return tokenResponse(token), nil
+}
+
+func tokenResponse(token *IssuedToken) *iamkey.CreateIamTokenResponse {
+	return &iamkey.CreateIamTokenResponse{
+		IamToken:  token.Token,
+		ExpiresAt: timestamppb.New(token.ExpiresAt),
+	}
+}
+
+// authenticate checks that call has IAM token issued by the token server.
+func (ts *TokenServer) authenticate(ctx context.Context) error {
+	token := bearerToken(ctx)
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "authorization required")
+	}
+	_, err := ts.ServiceAccount(token)
+	return err
+}
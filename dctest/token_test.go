@@ -0,0 +1,145 @@
+package dctest
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"testing"
+	"time"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/iamkey"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTokenServer(t *testing.T) *TokenServer {
+	ts := NewTokenServer(TokenServerConfig{})
+	t.Cleanup(ts.Close)
+	t.Setenv("DOUBLE_CLOUD_TOKEN_URL", ts.URL())
+	return ts
+}
+
+func serviceAccountCredentials(t *testing.T, ts *TokenServer, serviceAccountID string) (*iamkey.Key, dcsdk.ExchangeableCredentials) {
+	key, err := ts.NewServiceAccountKey(serviceAccountID)
+	require.NoError(t, err)
+	creds, err := dcsdk.ServiceAccountKey(key)
+	require.NoError(t, err)
+	return key, creds.(dcsdk.ExchangeableCredentials)
+}
+
+func TestTokenServer_SDK(t *testing.T) {
+	ctx := context.Background()
+	ts := newTokenServer(t)
+	_, creds := serviceAccountCredentials(t, ts, "sa-1")
+	srv := NewServer(Config{TokenServer: ts})
+	defer srv.Close()
+
+	anonymous, err := srv.SDK(ctx)
+	require.NoError(t, err)
+	_, err = anonymous.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	cfg := srv.Config()
+	cfg.Credentials = creds
+	cfg.TokenExchanger = ts.Exchanger()
+	sdk, err := dcsdk.Build(ctx, cfg, srv.DialOption())
+	require.NoError(t, err)
+	_, err = sdk.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{})
+	require.NoError(t, err)
+	issued := ts.Issued()
+	require.Len(t, issued, 1)
+	assert.Equal(t, "sa-1", issued[0].ServiceAccountID)
+
+	ts.ExpireTokens()
+	_, err = sdk.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{})
+	require.NoError(t, err)
+	assert.Len(t, ts.Issued(), 2)
+}
+
+func TestTokenServer_RejectsAssertion(t *testing.T) {
+	ctx := context.Background()
+	ts := newTokenServer(t)
+	key, creds := serviceAccountCredentials(t, ts, "sa-1")
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key.PrivateKey))
+	require.NoError(t, err)
+
+	for name, creds := range map[string]dcsdk.Credentials{
+		"issuer": must(dcsdk.ServiceAccountSigner(key.Id, "sa-2", privateKey)),
+		"kid":    must(dcsdk.ServiceAccountSigner("unknown", "sa-1", privateKey)),
+		"key":    must(dcsdk.ServiceAccountSigner(key.Id, "sa-1", otherKey(t))),
+	} {
+		req, err := creds.(dcsdk.ExchangeableCredentials).IAMTokenRequest()
+		require.NoError(t, err)
+		_, err = ts.Exchanger().Exchange(ctx, req)
+		var oauthErr *dcsdk.OAuthError
+		require.True(t, errors.As(err, &oauthErr), "%s: %v", name, err)
+		assert.Equal(t, "invalid_grant", oauthErr.Code, name)
+	}
+
+	req, err := creds.IAMTokenRequest()
+	require.NoError(t, err)
+	t.Setenv("DOUBLE_CLOUD_TOKEN_URL", "https://auth.double.cloud/oauth/token")
+	wrongAudience, err := creds.IAMTokenRequest()
+	require.NoError(t, err)
+	_, err = ts.Exchanger().Exchange(ctx, wrongAudience)
+	assert.ErrorContains(t, err, "audience")
+	_, err = ts.Exchanger().Exchange(ctx, req)
+	require.NoError(t, err)
+}
+
+func TestTokenServer_GRPC(t *testing.T) {
+	ctx := context.Background()
+	ts := newTokenServer(t)
+	_, creds := serviceAccountCredentials(t, ts, "sa-1")
+	conn, err := ts.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := iamkey.NewIamTokenServiceClient(conn)
+
+	req, err := creds.IAMTokenRequest()
+	require.NoError(t, err)
+	resp, err := client.Create(ctx, req)
+	require.NoError(t, err)
+	sa, err := ts.ServiceAccount(resp.IamToken)
+	require.NoError(t, err)
+	assert.Equal(t, "sa-1", sa)
+	assert.WithinDuration(t, time.Now().Add(DefaultTokenTTL), resp.ExpiresAt.AsTime(), time.Minute)
+
+	_, err = client.Create(ctx, &iamkey.CreateIamTokenRequest{Identity: &iamkey.CreateIamTokenRequest_Jwt{Jwt: "garbage"}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	delegate := &iamkey.CreateIamTokenForServiceAccountRequest{ServiceAccountId: "sa-2"}
+	callerCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+resp.IamToken)
+	_, err = client.CreateForServiceAccount(ctx, delegate)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.CreateForServiceAccount(callerCtx, delegate)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ts.AllowDelegation("sa-1", "sa-2")
+	delegated, err := client.CreateForServiceAccount(callerCtx, delegate)
+	require.NoError(t, err)
+	sa, err = ts.ServiceAccount(delegated.IamToken)
+	require.NoError(t, err)
+	assert.Equal(t, "sa-2", sa)
+}
+
+func must(creds dcsdk.Credentials, err error) dcsdk.Credentials {
+	if err != nil {
+		panic(err)
+	}
+	return creds
+}
+
+func otherKey(t *testing.T) *rsa.PrivateKey {
+	key, err := iamkey.Generate("other", iamkey.Key_RSA_2048)
+	require.NoError(t, err)
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key.PrivateKey))
+	require.NoError(t, err)
+	return privateKey
+}
@@ -0,0 +1,301 @@
+package dctest
+
+import (
+	"context"
+
+	trv1 "github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	transferPrefix         = "dtt"
+	transferEndpointPrefix = "dtep"
+)
+
+func (s *Server) registerTransfer() {
+	trv1.RegisterTransferServiceServer(s.grpc, &transfersService{s: s})
+	trv1.RegisterEndpointServiceServer(s.grpc, &transferEndpointService{s: s})
+	trv1.RegisterOperationServiceServer(s.grpc, &transferOperationService{s: s})
+}
+
+type transfersService struct {
+	trv1.UnimplementedTransferServiceServer
+	s *Server
+}
+
+func (c *transfersService) Get(ctx context.Context, req *trv1.GetTransferRequest) (*trv1.Transfer, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	transfer, err := c.s.transfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+	return clone(transfer), nil
+}
+
+func (c *transfersService) List(ctx context.Context, req *trv1.ListTransfersRequest) (*trv1.ListTransfersResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	transfers := listSorted(c.s.transfers, func(t *trv1.Transfer) bool {
+		return req.ProjectId == "" || t.ProjectId == req.ProjectId
+	})
+	transfers, next, err := page(transfers, req.Page.GetPageSize(), req.Page.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	return &trv1.ListTransfersResponse{Transfers: transfers, NextPageToken: next}, nil
+}
+
+func (c *transfersService) Create(ctx context.Context, req *trv1.CreateTransferRequest) (*dcv1.Operation, error) {
+	if err := required("name", req.Name); err != nil {
+		return nil, err
+	}
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	source, err := c.s.transferEndpoint(req.SourceId)
+	if err != nil {
+		return nil, err
+	}
+	target, err := c.s.transferEndpoint(req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	transfer := &trv1.Transfer{
+		Id:              c.s.newID(transferPrefix),
+		ProjectId:       projectOrDefault(req.ProjectId),
+		Name:            req.Name,
+		Description:     req.Description,
+		Labels:          req.Labels,
+		Source:          clone(source),
+		Target:          clone(target),
+		Runtime:         req.Runtime,
+		Status:          trv1.TransferStatus_CREATING,
+		Type:            req.Type,
+		RegularSnapshot: req.RegularSnapshot,
+		Transformation:  req.Transformation,
+		DataObjects:     req.DataObjects,
+	}
+	c.s.transfers[transfer.Id] = transfer
+	return c.s.startOperation(serviceTransfer, transfer.Id, "Create transfer", func() error {
+		return c.s.setTransferStatus(transfer.Id, trv1.TransferStatus_CREATED)
+	}), nil
+}
+
+func (c *transfersService) Update(ctx context.Context, req *trv1.UpdateTransferRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.transfer(req.TransferId); err != nil {
+		return nil, err
+	}
+	update := clone(req)
+	return c.s.startOperation(serviceTransfer, update.TransferId, "Update transfer", func() error {
+		transfer, err := c.s.transfer(update.TransferId)
+		if err != nil {
+			return err
+		}
+		if update.Name != "" {
+			transfer.Name = update.Name
+		}
+		if update.Description != "" {
+			transfer.Description = update.Description
+		}
+		if update.Labels != nil {
+			transfer.Labels = update.Labels
+		}
+		if update.Runtime != nil {
+			transfer.Runtime = update.Runtime
+		}
+		if update.RegularSnapshot != nil {
+			transfer.RegularSnapshot = update.RegularSnapshot
+		}
+		if update.Transformation != nil {
+			transfer.Transformation = update.Transformation
+		}
+		if update.DataObjects != nil {
+			transfer.DataObjects = update.DataObjects
+		}
+		return nil
+	}), nil
+}
+
+func (c *transfersService) Delete(ctx context.Context, req *trv1.DeleteTransferRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	transfer, err := c.s.transfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.Status == trv1.TransferStatus_RUNNING {
+		return nil, status.Errorf(codes.FailedPrecondition, "transfer %q must be deactivated first", transfer.Id)
+	}
+	id := transfer.Id
+	return c.s.startOperation(serviceTransfer, id, "Delete transfer", func() error {
+		if _, err := c.s.transfer(id); err != nil {
+			return err
+		}
+		delete(c.s.transfers, id)
+		return nil
+	}), nil
+}
+
+func (c *transfersService) Activate(ctx context.Context, req *trv1.ActivateTransferRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.transfer(req.TransferId); err != nil {
+		return nil, err
+	}
+	id := req.TransferId
+	return c.s.startOperation(serviceTransfer, id, "Activate transfer", func() error {
+		return c.s.setTransferStatus(id, trv1.TransferStatus_RUNNING)
+	}), nil
+}
+
+func (c *transfersService) Deactivate(ctx context.Context, req *trv1.DeactivateTransferRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	transfer, err := c.s.transfer(req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.Status != trv1.TransferStatus_RUNNING {
+		return nil, status.Errorf(codes.FailedPrecondition, "transfer %q is not running", transfer.Id)
+	}
+	transfer.Status = trv1.TransferStatus_STOPPING
+	id := transfer.Id
+	return c.s.startOperation(serviceTransfer, id, "Deactivate transfer", func() error {
+		return c.s.setTransferStatus(id, trv1.TransferStatus_STOPPED)
+	}), nil
+}
+
+type transferEndpointService struct {
+	trv1.UnimplementedEndpointServiceServer
+	s *Server
+}
+
+func (c *transferEndpointService) Get(ctx context.Context, req *trv1.GetEndpointRequest) (*trv1.Endpoint, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	endpoint, err := c.s.transferEndpoint(req.EndpointId)
+	if err != nil {
+		return nil, err
+	}
+	return clone(endpoint), nil
+}
+
+func (c *transferEndpointService) List(ctx context.Context, req *trv1.ListEndpointsRequest) (*trv1.ListEndpointsResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	endpoints := listSorted(c.s.endpoints, func(e *trv1.Endpoint) bool {
+		return req.ProjectId == "" || e.ProjectId == req.ProjectId
+	})
+	endpoints, next, err := pageNext(endpoints, req.Page)
+	if err != nil {
+		return nil, err
+	}
+	return &trv1.ListEndpointsResponse{Endpoints: endpoints, NextPage: next}, nil
+}
+
+func (c *transferEndpointService) Create(ctx context.Context, req *trv1.CreateEndpointRequest) (*dcv1.Operation, error) {
+	if err := required("name", req.Name); err != nil {
+		return nil, err
+	}
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	endpoint := &trv1.Endpoint{
+		Id:          c.s.newID(transferEndpointPrefix),
+		ProjectId:   projectOrDefault(req.ProjectId),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		Settings:    req.Settings,
+	}
+	return c.s.startOperation(serviceTransfer, endpoint.Id, "Create endpoint", func() error {
+		c.s.endpoints[endpoint.Id] = endpoint
+		return nil
+	}), nil
+}
+
+func (c *transferEndpointService) Update(ctx context.Context, req *trv1.UpdateEndpointRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.transferEndpoint(req.EndpointId); err != nil {
+		return nil, err
+	}
+	update := clone(req)
+	return c.s.startOperation(serviceTransfer, update.EndpointId, "Update endpoint", func() error {
+		endpoint, err := c.s.transferEndpoint(update.EndpointId)
+		if err != nil {
+			return err
+		}
+		if update.Name != "" {
+			endpoint.Name = update.Name
+		}
+		if update.Description != "" {
+			endpoint.Description = update.Description
+		}
+		if update.Labels != nil {
+			endpoint.Labels = update.Labels
+		}
+		if update.Settings != nil {
+			endpoint.Settings = update.Settings
+		}
+		return nil
+	}), nil
+}
+
+func (c *transferEndpointService) Delete(ctx context.Context, req *trv1.DeleteEndpointRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.transferEndpoint(req.EndpointId); err != nil {
+		return nil, err
+	}
+	for _, t := range c.s.transfers {
+		if t.Source.GetId() == req.EndpointId || t.Target.GetId() == req.EndpointId {
+			return nil, status.Errorf(codes.FailedPrecondition, "endpoint %q is used by transfer %q", req.EndpointId, t.Id)
+		}
+	}
+	id := req.EndpointId
+	return c.s.startOperation(serviceTransfer, id, "Delete endpoint", func() error {
+		delete(c.s.endpoints, id)
+		return nil
+	}), nil
+}
+
+type transferOperationService struct {
+	trv1.UnimplementedOperationServiceServer
+	s *Server
+}
+
+func (c *transferOperationService) Get(ctx context.Context, req *trv1.GetOperationRequest) (*dcv1.Operation, error) {
+	return c.s.pollOperation(ctx, serviceTransfer, req.OperationId)
+}
+
+// transfer must be called with mu held.
+func (s *Server) transfer(id string) (*trv1.Transfer, error) {
+	transfer, ok := s.transfers[id]
+	if !ok {
+		return nil, notFound("transfer", id)
+	}
+	return transfer, nil
+}
+
+// transferEndpoint must be called with mu held.
+func (s *Server) transferEndpoint(id string) (*trv1.Endpoint, error) {
+	endpoint, ok := s.endpoints[id]
+	if !ok {
+		return nil, notFound("endpoint", id)
+	}
+	return endpoint, nil
+}
+
+// setTransferStatus must be called with mu held.
+func (s *Server) setTransferStatus(id string, st trv1.TransferStatus) error {
+	transfer, err := s.transfer(id)
+	if err != nil {
+		return err
+	}
+	transfer.Status = st
+	return nil
+}
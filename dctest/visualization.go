@@ -0,0 +1,98 @@
+package dctest
+
+import (
+	"context"
+
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	vzv1 "github.com/doublecloud/go-genproto/doublecloud/visualization/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const workbookPrefix = "vzw"
+
+func (s *Server) registerVisualization() {
+	vzv1.RegisterWorkbookServiceServer(s.grpc, &workbookService{s: s})
+}
+
+// workbookService responds with done operations, as Visualization has no OperationService to poll.
+type workbookService struct {
+	vzv1.UnimplementedWorkbookServiceServer
+	s *Server
+}
+
+func (c *workbookService) Get(ctx context.Context, req *vzv1.GetWorkbookRequest) (*vzv1.GetWorkbookResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	workbook, err := c.s.workbook(req.WorkbookId)
+	if err != nil {
+		return nil, err
+	}
+	return clone(workbook), nil
+}
+
+func (c *workbookService) ListWorkbooks(ctx context.Context, req *vzv1.ListWorkbooksRequest) (*vzv1.ListWorkbooksResponse, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	workbooks := listSorted(c.s.workbooks, func(w *vzv1.GetWorkbookResponse) bool {
+		return req.ProjectId == "" || w.ProjectId == req.ProjectId
+	})
+	resp := &vzv1.ListWorkbooksResponse{}
+	for _, w := range workbooks {
+		resp.Workbooks = append(resp.Workbooks, &vzv1.WorkbooksIndexItem{Id: w.Id, Title: w.Title})
+	}
+	return resp, nil
+}
+
+func (c *workbookService) Create(ctx context.Context, req *vzv1.CreateWorkbookRequest) (*dcv1.Operation, error) {
+	if err := required("workbook_title", req.WorkbookTitle); err != nil {
+		return nil, err
+	}
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	workbook := &vzv1.GetWorkbookResponse{
+		Id:        c.s.newID(workbookPrefix),
+		Title:     req.WorkbookTitle,
+		ProjectId: projectOrDefault(req.ProjectId),
+		Workbook:  &vzv1.Workbook{Config: structpb.NewStructValue(&structpb.Struct{})},
+	}
+	return c.s.doneOperation(serviceVisualization, workbook.Id, "Create workbook", func() error {
+		c.s.workbooks[workbook.Id] = workbook
+		return nil
+	}), nil
+}
+
+func (c *workbookService) Update(ctx context.Context, req *vzv1.UpdateWorkbookRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	workbook, err := c.s.workbook(req.WorkbookId)
+	if err != nil {
+		return nil, err
+	}
+	return c.s.doneOperation(serviceVisualization, workbook.Id, "Update workbook", func() error {
+		if req.Workbook != nil {
+			workbook.Workbook = req.Workbook
+		}
+		return nil
+	}), nil
+}
+
+func (c *workbookService) Delete(ctx context.Context, req *vzv1.DeleteWorkbookRequest) (*dcv1.Operation, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+	if _, err := c.s.workbook(req.WorkbookId); err != nil {
+		return nil, err
+	}
+	return c.s.doneOperation(serviceVisualization, req.WorkbookId, "Delete workbook", func() error {
+		delete(c.s.workbooks, req.WorkbookId)
+		return nil
+	}), nil
+}
+
+// workbook must be called with mu held.
+func (s *Server) workbook(id string) (*vzv1.GetWorkbookResponse, error) {
+	workbook, ok := s.workbooks[id]
+	if !ok {
+		return nil, notFound("workbook", id)
+	}
+	return workbook, nil
+}
@@ -9,6 +9,7 @@ import (
 
 var (
 	federationID = flag.String("federation-id", "", "ID of federation to authorize")
+	headless     = flag.Bool("headless", false, "Do not open browser, paste redirect URL back instead")
 )
 
 func main() {
@@ -16,7 +17,11 @@ func main() {
 	if *federationID == "" {
 		panic("federation-id is required")
 	}
-	creds := dcsdk.NewFederationCredentials(&dcsdk.FederationConfig{FederationID: *federationID})
+	cfg := &dcsdk.FederationConfig{FederationID: *federationID}
+	if *headless {
+		cfg.Mode = dcsdk.FederationLoginPaste
+	}
+	creds := dcsdk.NewFederationCredentials(cfg)
 	iamToken, err := creds.IAMToken(context.Background())
 	if err != nil {
 		panic(err)
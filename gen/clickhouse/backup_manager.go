@@ -0,0 +1,205 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	clickhouse "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	"github.com/doublecloud/go-sdk/operation"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/grpc"
+)
+
+// RetentionPolicy defines which backups of a cluster are kept, all the others are deleted.
+// A backup is kept if any of the rules keeps it, backups that are not completed yet are always kept. Days and weeks are calendar days and ISO weeks in UTC,
+// counted back from the current one.
+type RetentionPolicy struct {
+	// KeepLast is a number of the newest backups to keep.
+	KeepLast int
+	// KeepDaily is a number of days to keep the newest backup of each day for.
+	KeepDaily int
+	// KeepWeekly is a number of weeks to keep the newest backup of each week for.
+	KeepWeekly int
+	// Types are types of backups the policy applies to, backups of other types are always kept.
+	// Default value: all types
+	Types []clickhouse.Backup_Type
+}
+
+// Validate checks that the policy keeps at least one backup.
+func (p *RetentionPolicy) Validate() error {
+	if p.KeepLast < 0 || p.KeepDaily < 0 || p.KeepWeekly < 0 {
+		return errors.New("retention policy: KeepLast, KeepDaily and KeepWeekly must not be negative")
+	}
+	if p.KeepLast == 0 && p.KeepDaily == 0 && p.KeepWeekly == 0 {
+		return errors.New("retention policy: KeepLast, KeepDaily or KeepWeekly required")
+	}
+	return nil
+}
+
+// Select splits backups into kept and deleted by the policy at time now. Both are ordered from the newest.
+func (p *RetentionPolicy) Select(backups []*clickhouse.Backup, now time.Time) (keep, del []*clickhouse.Backup) {
+	backups = append([]*clickhouse.Backup(nil), backups...)
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backupTime(backups[i]).After(backupTime(backups[j]))
+	})
+
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	firstDay := today.AddDate(0, 0, 1-p.KeepDaily)
+	// ISO week starts on Monday.
+	thisWeek := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	firstWeek := thisWeek.AddDate(0, 0, 7*(1-p.KeepWeekly))
+
+	days := map[string]bool{}
+	weeks := map[string]bool{}
+	last := 0
+	for _, b := range backups {
+		if b.CreateTime == nil || !p.applies(b) {
+			keep = append(keep, b)
+			continue
+		}
+		t := backupTime(b).UTC()
+		kept := false
+		if last < p.KeepLast {
+			last++
+			kept = true
+		}
+		if day := t.Format(time.DateOnly); p.KeepDaily > 0 && !t.Before(firstDay) && !days[day] {
+			days[day] = true
+			kept = true
+		}
+		year, w := t.ISOWeek()
+		if week := fmt.Sprintf("%d-%d", year, w); p.KeepWeekly > 0 && !t.Before(firstWeek) && !weeks[week] {
+			weeks[week] = true
+			kept = true
+		}
+		if kept {
+			keep = append(keep, b)
+		} else {
+			del = append(del, b)
+		}
+	}
+	return keep, del
+}
+
+func (p *RetentionPolicy) applies(b *clickhouse.Backup) bool {
+	if len(p.Types) == 0 {
+		return true
+	}
+	for _, t := range p.Types {
+		if b.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// backupTime is the time the backup was completed, or started if it's not completed yet.
+func backupTime(b *clickhouse.Backup) time.Time {
+	if b.CreateTime != nil {
+		return b.CreateTime.AsTime()
+	}
+	return b.StartTime.AsTime()
+}
+
+// RetentionPlan is a result of applying RetentionPolicy to backups of a cluster.
+type RetentionPlan struct {
+	ClusterID string
+	Keep      []*clickhouse.Backup
+	Delete    []*clickhouse.Backup
+}
+
+// BackupManagerConfig configures BackupManager.
+type BackupManagerConfig struct {
+	Policy RetentionPolicy
+	// DryRun makes Enforce only plan deletion of backups.
+	DryRun bool
+}
+
+// BackupManager applies retention policy to backups of ClickHouse clusters:
+//
+//	m, err := clickhouse.NewBackupManager(sdk.API().ClickHouse(), clickhouse.BackupManagerConfig{
+//		Policy: clickhouse.RetentionPolicy{KeepLast: 3, KeepDaily: 7, KeepWeekly: 4},
+//	})
+//	plan, err := m.Enforce(ctx, clusterID)
+type BackupManager struct {
+	clickHouse API
+	cfg        BackupManagerConfig
+}
+
+// NewBackupManager creates BackupManager that uses clients of c.
+func NewBackupManager(c API, cfg BackupManagerConfig) (*BackupManager, error) {
+	if err := cfg.Policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &BackupManager{clickHouse: c, cfg: cfg}, nil
+}
+
+// Backups lists backups of the cluster ordered from the newest.
+func (m *BackupManager) Backups(ctx context.Context, clusterID string, opts ...grpc.CallOption) ([]*clickhouse.Backup, error) {
+	response, err := m.clickHouse.Cluster().ListBackups(ctx, &clickhouse.ListClusterBackupsRequest{ClusterId: clusterID}, opts...)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "cluster %s backups list failed", clusterID)
+	}
+	backups := response.Backups
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backupTime(backups[i]).After(backupTime(backups[j]))
+	})
+	return backups, nil
+}
+
+// Plan computes which backups of the cluster are deleted by the policy.
+func (m *BackupManager) Plan(ctx context.Context, clusterID string, opts ...grpc.CallOption) (*RetentionPlan, error) {
+	backups, err := m.Backups(ctx, clusterID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	keep, del := m.cfg.Policy.Select(backups, time.Now())
+	return &RetentionPlan{ClusterID: clusterID, Keep: keep, Delete: del}, nil
+}
+
+// Enforce deletes backups of the cluster that are not kept by the policy one by one, waiting for each deletion.
+// In dry-run mode nothing is deleted. Returns the plan, also if a deletion failed.
+func (m *BackupManager) Enforce(ctx context.Context, clusterID string, opts ...grpc.CallOption) (*RetentionPlan, error) {
+	plan, err := m.Plan(ctx, clusterID, opts...)
+	if err != nil || m.cfg.DryRun {
+		return plan, err
+	}
+	for _, b := range plan.Delete {
+		op, err := m.clickHouse.Backup().Delete(ctx, &clickhouse.DeleteBackupRequest{BackupId: b.Id}, opts...)
+		if err == nil {
+			err = operation.New(m.clickHouse.Operation(), op).Wait(ctx, opts...)
+		}
+		if err != nil {
+			return plan, sdkerrors.WithMessagef(err, "backup %s delete failed", b.Id)
+		}
+	}
+	return plan, nil
+}
+
+// Latest returns the newest completed backup of the cluster.
+func (m *BackupManager) Latest(ctx context.Context, clusterID string, opts ...grpc.CallOption) (*clickhouse.Backup, error) {
+	backups, err := m.Backups(ctx, clusterID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range backups {
+		if b.CreateTime != nil {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("cluster %s has no completed backups", clusterID)
+}
+
+// RestoreLatest restores the newest completed backup of the cluster to a new cluster of target spec
+// and waits until it is restored, see Provisioner.Restore.
+func (m *BackupManager) RestoreLatest(ctx context.Context, clusterID string, target *ClusterSpec, opts ...grpc.CallOption) (*ProvisionedCluster, error) {
+	backup, err := m.Latest(ctx, clusterID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewProvisioner(m.clickHouse).Restore(ctx, backup.Id, target, opts...)
+}
@@ -0,0 +1,118 @@
+package clickhouse_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	"github.com/doublecloud/go-sdk/gen/clickhouse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func backupIDs(backups []*chv1.Backup) []string {
+	var ids []string
+	for _, b := range backups {
+		ids = append(ids, b.Id)
+	}
+	return ids
+}
+
+func TestRetentionPolicy_Select(t *testing.T) {
+	// Wednesday, ISO week 24.
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+	at := func(id string, t time.Time) *chv1.Backup {
+		return &chv1.Backup{Id: id, CreateTime: timestamppb.New(t), Type: chv1.Backup_TYPE_AUTOMATED}
+	}
+	backups := []*chv1.Backup{
+		at("sun-2", time.Date(2024, 6, 2, 9, 0, 0, 0, time.UTC)),
+		at("wed-11", now.Add(-time.Hour)),
+		at("wed-10", now.Add(-2*time.Hour)),
+		at("tue-20", time.Date(2024, 6, 11, 20, 0, 0, 0, time.UTC)),
+		at("tue-8", time.Date(2024, 6, 11, 8, 0, 0, 0, time.UTC)),
+		at("mon", time.Date(2024, 6, 10, 9, 0, 0, 0, time.UTC)),
+		at("sun-9", time.Date(2024, 6, 9, 9, 0, 0, 0, time.UTC)),
+		at("sat", time.Date(2024, 6, 8, 9, 0, 0, 0, time.UTC)),
+		{Id: "running", StartTime: timestamppb.New(now)},
+	}
+
+	policy := clickhouse.RetentionPolicy{KeepLast: 1, KeepDaily: 3, KeepWeekly: 2}
+	keep, del := policy.Select(backups, now)
+	assert.Equal(t, []string{"running", "wed-11", "tue-20", "mon", "sun-9"}, backupIDs(keep))
+	assert.Equal(t, []string{"wed-10", "tue-8", "sat", "sun-2"}, backupIDs(del))
+
+	policy = clickhouse.RetentionPolicy{KeepLast: 2, Types: []chv1.Backup_Type{chv1.Backup_TYPE_MANUAL}}
+	keep, del = policy.Select(backups, now)
+	assert.Len(t, keep, len(backups))
+	assert.Empty(t, del)
+}
+
+func TestRetentionPolicy_Validate(t *testing.T) {
+	assert.Error(t, (&clickhouse.RetentionPolicy{}).Validate())
+	assert.Error(t, (&clickhouse.RetentionPolicy{KeepLast: 1, KeepDaily: -1}).Validate())
+	assert.NoError(t, (&clickhouse.RetentionPolicy{KeepWeekly: 1}).Validate())
+}
+
+func TestBackupManager(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newProvisioner(t)
+	sdk, err := srv.SDK(ctx)
+	require.NoError(t, err)
+	source, err := p.Provision(ctx, spec())
+	require.NoError(t, err)
+	clusterID := source.Cluster.Id
+	now := time.Now()
+	for i := 1; i <= 4; i++ {
+		_, err := srv.AddClickHouseBackup(&chv1.Backup{
+			Name:            "automated",
+			SourceClusterId: clusterID,
+			CreateTime:      timestamppb.New(now.Add(-time.Duration(i) * time.Hour)),
+		})
+		require.NoError(t, err)
+	}
+	manual, err := srv.AddClickHouseBackup(&chv1.Backup{
+		Name:            "manual",
+		SourceClusterId: clusterID,
+		CreateTime:      timestamppb.New(now.Add(-10 * time.Hour)),
+		Type:            chv1.Backup_TYPE_MANUAL,
+	})
+	require.NoError(t, err)
+
+	cfg := clickhouse.BackupManagerConfig{
+		Policy: clickhouse.RetentionPolicy{KeepLast: 2, Types: []chv1.Backup_Type{chv1.Backup_TYPE_AUTOMATED}},
+		DryRun: true,
+	}
+	m, err := clickhouse.NewBackupManager(sdk.API().ClickHouse(), cfg)
+	require.NoError(t, err)
+	plan, err := m.Enforce(ctx, clusterID)
+	require.NoError(t, err)
+	assert.Len(t, plan.Keep, 3)
+	assert.Len(t, plan.Delete, 2)
+	backups, err := m.Backups(ctx, clusterID)
+	require.NoError(t, err)
+	assert.Len(t, backups, 5, "dry run deletes nothing")
+
+	cfg.DryRun = false
+	m, err = clickhouse.NewBackupManager(sdk.API().ClickHouse(), cfg)
+	require.NoError(t, err)
+	_, err = m.Enforce(ctx, clusterID)
+	require.NoError(t, err)
+	backups, err = m.Backups(ctx, clusterID)
+	require.NoError(t, err)
+	assert.Equal(t, backupIDs(plan.Keep), backupIDs(backups))
+	assert.Contains(t, backupIDs(backups), manual.Id)
+
+	latest, err := m.Latest(ctx, clusterID)
+	require.NoError(t, err)
+	assert.Equal(t, backups[0].Id, latest.Id)
+	restored, err := m.RestoreLatest(ctx, clusterID, &clickhouse.ClusterSpec{Name: "restored"})
+	require.NoError(t, err)
+	assert.Equal(t, "restored", restored.Cluster.Name)
+	assert.Equal(t, source.Cluster.Version, restored.Cluster.Version)
+	assert.Len(t, restored.Hosts, len(source.Hosts))
+
+	_, err = clickhouse.NewBackupManager(sdk.API().ClickHouse(), clickhouse.BackupManagerConfig{})
+	assert.Error(t, err)
+}
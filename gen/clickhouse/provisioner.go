@@ -0,0 +1,221 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	clickhouse "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	"github.com/doublecloud/go-sdk/operation"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// DefaultCloudType is used for clusters created from ClusterSpec without cloud type.
+const DefaultCloudType = "aws"
+
+// ClusterSpec is a compact specification of ClickHouse cluster created by Provisioner.
+type ClusterSpec struct {
+	ProjectID   string
+	Name        string
+	Description string
+	// CloudType is a cloud provider of the cluster.
+	// Default value: DefaultCloudType
+	CloudType string
+	RegionID  string
+	// Version is ID of ClickHouse version, it must be listed by VersionService and not deprecated.
+	// Default value: chosen by the service
+	Version string
+	// ShardCount and ReplicaCount are chosen by the service if zero.
+	ShardCount   int64
+	ReplicaCount int64
+	// ResourcePresetID is a preset of hosts resources, e.g. "s2-c2-m4".
+	ResourcePresetID string
+	// DiskSize is storage size of a host in bytes, chosen by the service if zero.
+	DiskSize  int64
+	NetworkID string
+}
+
+// CreateClusterRequest returns request that creates cluster of the spec.
+func (s *ClusterSpec) CreateClusterRequest() *clickhouse.CreateClusterRequest {
+	cloudType := s.CloudType
+	if cloudType == "" {
+		cloudType = DefaultCloudType
+	}
+	return &clickhouse.CreateClusterRequest{
+		ProjectId:   s.ProjectID,
+		CloudType:   cloudType,
+		RegionId:    s.RegionID,
+		Name:        s.Name,
+		Description: s.Description,
+		Version:     s.Version,
+		Resources:   s.resources(),
+		NetworkId:   s.NetworkID,
+	}
+}
+
+// RestoreClusterRequest returns request that restores backup to a new cluster of the spec.
+// Version and resources that are not specified are taken from the backup source cluster.
+func (s *ClusterSpec) RestoreClusterRequest(backupID string) *clickhouse.RestoreClusterRequest {
+	req := &clickhouse.RestoreClusterRequest{
+		BackupId:    backupID,
+		ProjectId:   s.ProjectID,
+		RegionId:    s.RegionID,
+		Name:        s.Name,
+		Description: s.Description,
+		Version:     s.Version,
+		NetworkId:   s.NetworkID,
+	}
+	if s.ResourcePresetID != "" || s.DiskSize > 0 || s.ShardCount > 0 || s.ReplicaCount > 0 {
+		req.Resources = s.resources()
+	}
+	return req
+}
+
+func (s *ClusterSpec) resources() *clickhouse.ClusterResources {
+	resources := &clickhouse.ClusterResources_Clickhouse{ResourcePresetId: s.ResourcePresetID}
+	if s.DiskSize > 0 {
+		resources.DiskSize = wrapperspb.Int64(s.DiskSize)
+	}
+	if s.ShardCount > 0 {
+		resources.ShardCount = wrapperspb.Int64(s.ShardCount)
+	}
+	if s.ReplicaCount > 0 {
+		resources.ReplicaCount = wrapperspb.Int64(s.ReplicaCount)
+	}
+	return &clickhouse.ClusterResources{Clickhouse: resources}
+}
+
+func (s *ClusterSpec) validate() error {
+	return s.validateFields([]struct{ name, value string }{
+		{"ProjectID", s.ProjectID},
+		{"Name", s.Name},
+		{"RegionID", s.RegionID},
+		{"ResourcePresetID", s.ResourcePresetID},
+	})
+}
+
+func (s *ClusterSpec) validateFields(required []struct{ name, value string }) error {
+	var missing []string
+	for _, field := range required {
+		if field.value == "" {
+			missing = append(missing, field.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("cluster spec: %s required", strings.Join(missing, ", "))
+	}
+	if s.ShardCount < 0 || s.ReplicaCount < 0 || s.DiskSize < 0 {
+		return errors.New("cluster spec: ShardCount, ReplicaCount and DiskSize must not be negative")
+	}
+	return nil
+}
+
+// ProvisionedCluster is a cluster created by Provisioner.
+type ProvisionedCluster struct {
+	Cluster *clickhouse.Cluster
+	// Hosts are hosts of the cluster to connect to.
+	Hosts []*clickhouse.Host
+}
+
+// API is a set of ClickHouse service clients used by Provisioner and BackupManager.
+// It is implemented by dcsdk.ClickHouseAPI, so sdk.API().ClickHouse() or its mock may be passed.
+type API interface {
+	Backup() clickhouse.BackupServiceClient
+	Cluster() clickhouse.ClusterServiceClient
+	Operation() clickhouse.OperationServiceClient
+	Version() clickhouse.VersionServiceClient
+}
+
+// Provisioner creates ClickHouse clusters from ClusterSpec and waits until they are ready:
+//
+//	cluster, err := clickhouse.NewProvisioner(sdk.API().ClickHouse()).Provision(ctx, &clickhouse.ClusterSpec{...})
+type Provisioner struct {
+	clickHouse API
+}
+
+// NewProvisioner creates Provisioner that uses clients of c.
+func NewProvisioner(c API) *Provisioner {
+	return &Provisioner{clickHouse: c}
+}
+
+// Validate checks that spec is complete and its version is available.
+func (p *Provisioner) Validate(ctx context.Context, spec *ClusterSpec, opts ...grpc.CallOption) error {
+	if err := spec.validate(); err != nil {
+		return err
+	}
+	return p.validateVersion(ctx, spec.Version, opts...)
+}
+
+func (p *Provisioner) validateVersion(ctx context.Context, version string, opts ...grpc.CallOption) error {
+	if version == "" {
+		return nil
+	}
+	versions, err := p.clickHouse.Version().List(ctx, &clickhouse.ListVersionsRequest{}, opts...)
+	if err != nil {
+		return sdkerrors.WithMessage(err, "clickhouse versions list failed")
+	}
+	var available []string
+	for _, v := range versions.Versions {
+		if v.Id == version {
+			if v.Deprecated {
+				return fmt.Errorf("clickhouse version %q is deprecated", version)
+			}
+			return nil
+		}
+		if !v.Deprecated {
+			available = append(available, v.Id)
+		}
+	}
+	return fmt.Errorf("clickhouse version %q is not available, available versions: %s", version, strings.Join(available, ", "))
+}
+
+// Provision validates spec, creates cluster and waits for the create operation to finish.
+// If the operation fails, returned error mentions ID of the cluster, that may have to be deleted.
+func (p *Provisioner) Provision(ctx context.Context, spec *ClusterSpec, opts ...grpc.CallOption) (*ProvisionedCluster, error) {
+	if err := p.Validate(ctx, spec, opts...); err != nil {
+		return nil, err
+	}
+	proto, err := p.clickHouse.Cluster().Create(ctx, spec.CreateClusterRequest(), opts...)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "cluster %s create failed", spec.Name)
+	}
+	return p.wait(ctx, proto, "create", opts...)
+}
+
+// Restore restores backup to a new cluster of spec and waits for the restore operation to finish.
+// Only Name of spec is required, see ClusterSpec.RestoreClusterRequest.
+func (p *Provisioner) Restore(ctx context.Context, backupID string, spec *ClusterSpec, opts ...grpc.CallOption) (*ProvisionedCluster, error) {
+	if err := spec.validateFields([]struct{ name, value string }{{"Name", spec.Name}}); err != nil {
+		return nil, err
+	}
+	if err := p.validateVersion(ctx, spec.Version, opts...); err != nil {
+		return nil, err
+	}
+	proto, err := p.clickHouse.Cluster().Restore(ctx, spec.RestoreClusterRequest(backupID), opts...)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "backup %s restore failed", backupID)
+	}
+	return p.wait(ctx, proto, "restore", opts...)
+}
+
+// wait waits for operation that creates cluster and gets the cluster.
+func (p *Provisioner) wait(ctx context.Context, proto *operation.Proto, action string, opts ...grpc.CallOption) (*ProvisionedCluster, error) {
+	clusters := p.clickHouse.Cluster()
+	op := operation.New(p.clickHouse.Operation(), proto)
+	if err := op.Wait(ctx, opts...); err != nil {
+		return nil, sdkerrors.WithMessagef(err, "cluster %s %s failed", op.ResourceId(), action)
+	}
+
+	cluster, err := clusters.Get(ctx, &clickhouse.GetClusterRequest{ClusterId: op.ResourceId()}, opts...)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "cluster %s get failed", op.ResourceId())
+	}
+	hosts, err := clusters.ListHosts(ctx, &clickhouse.ListClusterHostsRequest{ClusterId: cluster.Id}, opts...)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "cluster %s hosts list failed", cluster.Id)
+	}
+	return &ProvisionedCluster{Cluster: cluster, Hosts: hosts.Hosts}, nil
+}
@@ -0,0 +1,125 @@
+package clickhouse_test
+
+import (
+	"context"
+	"testing"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	"github.com/doublecloud/go-sdk/dctest"
+	"github.com/doublecloud/go-sdk/gen/clickhouse"
+	"github.com/doublecloud/go-sdk/mocks"
+	chmocks "github.com/doublecloud/go-sdk/mocks/clickhouse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newProvisioner(t *testing.T) (*dctest.Server, *clickhouse.Provisioner) {
+	srv := dctest.NewServer(dctest.Config{})
+	t.Cleanup(srv.Close)
+	sdk, err := srv.SDK(context.Background())
+	require.NoError(t, err)
+	return srv, clickhouse.NewProvisioner(sdk.API().ClickHouse())
+}
+
+func spec() *clickhouse.ClusterSpec {
+	return &clickhouse.ClusterSpec{
+		ProjectID:        "p1",
+		Name:             "analytics",
+		RegionID:         "eu-central-1",
+		Version:          "24.8",
+		ShardCount:       2,
+		ReplicaCount:     3,
+		ResourcePresetID: "s2-c2-m4",
+		DiskSize:         64 << 30,
+		NetworkID:        "network-1",
+	}
+}
+
+func TestProvisioner_Provision(t *testing.T) {
+	ctx := context.Background()
+	_, p := newProvisioner(t)
+
+	result, err := p.Provision(ctx, spec())
+	require.NoError(t, err)
+	cluster := result.Cluster
+	assert.Equal(t, dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE, cluster.Status)
+	assert.Equal(t, "analytics", cluster.Name)
+	assert.Equal(t, "aws", cluster.CloudType)
+	assert.Equal(t, "eu-central-1", cluster.RegionId)
+	assert.Equal(t, "24.8", cluster.Version)
+	assert.Equal(t, "network-1", cluster.NetworkId)
+	resources := cluster.Resources.GetClickhouse()
+	assert.Equal(t, "s2-c2-m4", resources.ResourcePresetId)
+	assert.Equal(t, int64(64<<30), resources.DiskSize.GetValue())
+
+	require.Len(t, result.Hosts, 6)
+	shards := map[string]int{}
+	for _, host := range result.Hosts {
+		assert.Equal(t, cluster.Id, host.ClusterId)
+		assert.Equal(t, dcv1.HostStatus_HOST_STATUS_ALIVE, host.Status)
+		shards[host.ShardName]++
+	}
+	assert.Equal(t, map[string]int{"shard1": 3, "shard2": 3}, shards)
+}
+
+func TestProvisioner_Validate(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newProvisioner(t)
+
+	s := spec()
+	s.Version = ""
+	require.NoError(t, p.Validate(ctx, s))
+	assert.Zero(t, srv.Calls(chv1.VersionService_List_FullMethodName), "version without spec version is not validated")
+
+	s = spec()
+	s.Version = "23.8"
+	assert.ErrorContains(t, p.Validate(ctx, s), "deprecated")
+	s.Version = "22.1"
+	assert.EqualError(t, p.Validate(ctx, s), `clickhouse version "22.1" is not available, available versions: 24.8, 24.3`)
+
+	s = spec()
+	s.Name, s.ResourcePresetID = "", ""
+	assert.EqualError(t, p.Validate(ctx, s), "cluster spec: Name, ResourcePresetID required")
+	s = spec()
+	s.ShardCount = -1
+	assert.ErrorContains(t, p.Validate(ctx, s), "negative")
+
+	_, err := p.Provision(ctx, s)
+	require.Error(t, err)
+	assert.Zero(t, srv.Calls(chv1.ClusterService_Create_FullMethodName))
+}
+
+func TestProvisioner_OperationFailed(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newProvisioner(t)
+	srv.InjectOperationFault(dctest.OperationFault{
+		Method:  chv1.ClusterService_Create_FullMethodName,
+		Code:    codes.ResourceExhausted,
+		Message: "quota exceeded",
+	})
+
+	_, err := p.Provision(ctx, spec())
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Regexp(t, `cluster chc\S+ create failed`, err.Error())
+}
+
+func TestProvisioner_ValidateMocks(t *testing.T) {
+	ctx := context.Background()
+	versions := chmocks.NewVersionServiceClient(t)
+	versions.EXPECT().
+		List(mock.Anything, &chv1.ListVersionsRequest{}).
+		Return(&chv1.ListVersionsResponse{Versions: []*chv1.Version{{Id: "24.3", Deprecated: true}, {Id: "24.8"}}}, nil)
+	api := mocks.NewClickHouseAPI(t)
+	api.EXPECT().Version().Return(versions)
+	p := clickhouse.NewProvisioner(api)
+
+	s := spec()
+	require.NoError(t, p.Validate(ctx, s))
+	s.Version = "24.3"
+	assert.ErrorContains(t, p.Validate(ctx, s), "deprecated")
+}
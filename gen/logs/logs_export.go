@@ -53,6 +53,15 @@ func (c *ExportServiceClient) List(ctx context.Context, in *logs.ListExportReque
 	return logs.NewLogExportServiceClient(conn).List(ctx, in, opts...)
 }
 
+// Update implements logs.LogExportServiceClient
+func (c *ExportServiceClient) Update(ctx context.Context, in *logs.UpdateExportRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return logs.NewLogExportServiceClient(conn).Update(ctx, in, opts...)
+}
+
 type ExportIterator struct {
 	ctx  context.Context
 	opts []grpc.CallOption
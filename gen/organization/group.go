@@ -2,6 +2,7 @@ package organization
 
 import (
 	"context"
+	"github.com/doublecloud/go-genproto/doublecloud/access/v1"
 	"github.com/doublecloud/go-genproto/doublecloud/organizationmanager/v1"
 	"github.com/doublecloud/go-genproto/doublecloud/v1"
 	"google.golang.org/grpc"
@@ -34,3 +35,67 @@ func (c *GroupServiceClient) Get(ctx context.Context, in *organizationmanager.Ge
 	}
 	return organizationmanager.NewGroupServiceClient(conn).Get(ctx, in, opts...)
 }
+
+func (c *GroupServiceClient) List(ctx context.Context, in *organizationmanager.ListGroupsRequest, opts ...grpc.CallOption) (*organizationmanager.ListGroupsResponse, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn).List(ctx, in, opts...)
+}
+
+func (c *GroupServiceClient) ListAccessBindings(ctx context.Context, in *access.ListAccessBindingsRequest, opts ...grpc.CallOption) (*access.ListAccessBindingsResponse, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn).ListAccessBindings(ctx, in, opts...)
+}
+
+func (c *GroupServiceClient) ListMembers(ctx context.Context, in *organizationmanager.ListGroupMembersRequest, opts ...grpc.CallOption) (*organizationmanager.ListGroupMembersResponse, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn).ListMembers(ctx, in, opts...)
+}
+
+func (c *GroupServiceClient) ListOperations(ctx context.Context, in *organizationmanager.ListGroupOperationsRequest, opts ...grpc.CallOption) (*organizationmanager.ListGroupOperationsResponse, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn).ListOperations(ctx, in, opts...)
+}
+
+func (c *GroupServiceClient) SetAccessBindings(ctx context.Context, in *access.SetAccessBindingsRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn).SetAccessBindings(ctx, in, opts...)
+}
+
+func (c *GroupServiceClient) Update(ctx context.Context, in *organizationmanager.UpdateGroupRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn).Update(ctx, in, opts...)
+}
+
+func (c *GroupServiceClient) UpdateAccessBindings(ctx context.Context, in *access.UpdateAccessBindingsRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn).UpdateAccessBindings(ctx, in, opts...)
+}
+
+func (c *GroupServiceClient) UpdateMembers(ctx context.Context, in *organizationmanager.UpdateGroupMembersRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupServiceClient(conn).UpdateMembers(ctx, in, opts...)
+}
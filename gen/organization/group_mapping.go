@@ -34,3 +34,27 @@ func (c *GroupMappingServiceClient) Get(ctx context.Context, in *organizationman
 	}
 	return organizationmanager.NewGroupMappingServiceClient(conn).Get(ctx, in, opts...)
 }
+
+func (c *GroupMappingServiceClient) ListItems(ctx context.Context, in *organizationmanager.ListGroupMappingItemsRequest, opts ...grpc.CallOption) (*organizationmanager.ListGroupMappingItemsResponse, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupMappingServiceClient(conn).ListItems(ctx, in, opts...)
+}
+
+func (c *GroupMappingServiceClient) Update(ctx context.Context, in *organizationmanager.UpdateGroupMappingRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupMappingServiceClient(conn).Update(ctx, in, opts...)
+}
+
+func (c *GroupMappingServiceClient) UpdateItems(ctx context.Context, in *organizationmanager.UpdateGroupMappingItemsRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizationmanager.NewGroupMappingServiceClient(conn).UpdateItems(ctx, in, opts...)
+}
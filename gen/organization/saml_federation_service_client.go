@@ -34,3 +34,11 @@ func (c *SamlFederationServiceClient) Get(ctx context.Context, in *saml.GetFeder
 	}
 	return saml.NewFederationServiceClient(conn).Get(ctx, in, opts...)
 }
+
+func (c *SamlFederationServiceClient) Update(ctx context.Context, in *saml.UpdateFederationRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return saml.NewFederationServiceClient(conn).Update(ctx, in, opts...)
+}
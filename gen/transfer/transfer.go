@@ -53,6 +53,15 @@ func (c *TransferServiceClient) Delete(ctx context.Context, in *transfer.DeleteT
 	return transfer.NewTransferServiceClient(conn).Delete(ctx, in, opts...)
 }
 
+// DeleteMetricExporterConnectionInfo implements transfer.TransferServiceClient
+func (c *TransferServiceClient) DeleteMetricExporterConnectionInfo(ctx context.Context, in *transfer.DeleteExporterConnectionInfoRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return transfer.NewTransferServiceClient(conn).DeleteMetricExporterConnectionInfo(ctx, in, opts...)
+}
+
 // Get implements transfer.TransferServiceClient
 func (c *TransferServiceClient) Get(ctx context.Context, in *transfer.GetTransferRequest, opts ...grpc.CallOption) (*transfer.Transfer, error) {
 	conn, err := c.getConn(ctx)
@@ -62,6 +71,24 @@ func (c *TransferServiceClient) Get(ctx context.Context, in *transfer.GetTransfe
 	return transfer.NewTransferServiceClient(conn).Get(ctx, in, opts...)
 }
 
+// GetMetricExporterConnectionInfo implements transfer.TransferServiceClient
+func (c *TransferServiceClient) GetMetricExporterConnectionInfo(ctx context.Context, in *transfer.MetricExporterConnectionInfoRequest, opts ...grpc.CallOption) (*transfer.MetricExporterConnectionInfoMetadata, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return transfer.NewTransferServiceClient(conn).GetMetricExporterConnectionInfo(ctx, in, opts...)
+}
+
+// GetMetrics implements transfer.TransferServiceClient
+func (c *TransferServiceClient) GetMetrics(ctx context.Context, in *transfer.GetMetricsRequest, opts ...grpc.CallOption) (*transfer.TransferMetrics, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return transfer.NewTransferServiceClient(conn).GetMetrics(ctx, in, opts...)
+}
+
 // List implements transfer.TransferServiceClient
 func (c *TransferServiceClient) List(ctx context.Context, in *transfer.ListTransfersRequest, opts ...grpc.CallOption) (*transfer.ListTransfersResponse, error) {
 	conn, err := c.getConn(ctx)
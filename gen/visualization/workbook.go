@@ -80,6 +80,24 @@ func (c *WorkbookServiceClient) GetConnection(ctx context.Context, in *visualiza
 	return visualization.NewWorkbookServiceClient(conn).GetConnection(ctx, in, opts...)
 }
 
+// GetErrorDetails implements visualization.WorkbookServiceClient
+func (c *WorkbookServiceClient) GetErrorDetails(ctx context.Context, in *visualization.ErrorDetailsRequest, opts ...grpc.CallOption) (*visualization.ErrorDetailsResponse, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return visualization.NewWorkbookServiceClient(conn).GetErrorDetails(ctx, in, opts...)
+}
+
+// ListWorkbooks implements visualization.WorkbookServiceClient
+func (c *WorkbookServiceClient) ListWorkbooks(ctx context.Context, in *visualization.ListWorkbooksRequest, opts ...grpc.CallOption) (*visualization.ListWorkbooksResponse, error) {
+	conn, err := c.getConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return visualization.NewWorkbookServiceClient(conn).ListWorkbooks(ctx, in, opts...)
+}
+
 // Update implements visualization.WorkbookServiceClient
 func (c *WorkbookServiceClient) Update(ctx context.Context, in *visualization.UpdateWorkbookRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
 	conn, err := c.getConn(ctx)
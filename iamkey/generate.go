@@ -0,0 +1,48 @@
+package iamkey
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Generate creates a new RSA key pair for the service account locally.
+// Public part of the key should be uploaded to IAM. Id of the uploaded key must be set
+// to the returned Key before it can be used for authorization.
+// Key_RSA_2048 is used for Key_ALGORITHM_UNSPECIFIED algorithm.
+func Generate(serviceAccountID string, algorithm Key_Algorithm) (*Key, error) {
+	var bits int
+	switch algorithm {
+	case Key_ALGORITHM_UNSPECIFIED, Key_RSA_2048:
+		algorithm = Key_RSA_2048
+		bits = 2048
+	case Key_RSA_4096:
+		bits = 4096
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %v", algorithm)
+	}
+	privateKey, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "key generation fail")
+	}
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "private key marshal fail")
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "public key marshal fail")
+	}
+	return &Key{
+		Subject:      &Key_ServiceAccountId{ServiceAccountId: serviceAccountID},
+		CreatedAt:    timestamppb.Now(),
+		KeyAlgorithm: algorithm,
+		PublicKey:    string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		PrivateKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+	}, nil
+}
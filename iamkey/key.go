@@ -1,15 +1,18 @@
 package iamkey
 
 import (
+	"bytes"
+	"encoding/json"
 	"io/ioutil" //nolint:staticcheck
 
 	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
 )
 
 var (
-// _ yaml.Marshaler   = &gen.Key{}
-// _ yaml.Unmarshaler = &gen.Key{}
+	_ yaml.Marshaler   = &Key{}
+	_ yaml.Unmarshaler = &Key{}
 )
 
 // // New creates new Key from IAM Key Service Create response.
@@ -56,35 +59,44 @@ func (m *Key) MarshalJSON() ([]byte, error) {
 
 // UnmarshalYAML unmarshals IAM Key YAML data.
 // Both snake_case (gRPC API) and camelCase (REST API) fields are accepted.
-// func (m *Key) UnmarshalYAML(unmarshal func(interface{}) error) error {
-// 	var obj yaml.MapSlice
-// 	err := unmarshal(&obj)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	yamlData, err := yaml.Marshal(obj)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	jsonData, err := yaml2json.YAMLToJSON(yamlData)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	return m.UnmarshalJSON(jsonData)
-// }
+func (m *Key) UnmarshalYAML(value *yaml.Node) error {
+	var obj interface{}
+	err := value.Decode(&obj)
+	if err != nil {
+		return err
+	}
+	jsonData, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return m.UnmarshalJSON(jsonData)
+}
 
-// func (m *Key) MarshalYAML() (interface{}, error) {
-// 	jsonData, err := m.MarshalJSON()
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	var obj yaml.MapSlice
-// 	err = yaml.Unmarshal(jsonData, &obj)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return obj, nil
-// }
+func (m *Key) MarshalYAML() (interface{}, error) {
+	jsonData, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	// JSON is valid YAML, decoding it into a node preserves fields order.
+	var doc yaml.Node
+	err = yaml.Unmarshal(jsonData, &doc)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	resetStyle(doc.Content[0])
+	return doc.Content[0], nil
+}
+
+// resetStyle drops JSON flow and quoting styles, so multiline private key is written as a literal block.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetStyle(n)
+	}
+}
 
 // ReadFromJSONFile reads IAM Key from JSON bytes.
 func ReadFromJSONBytes(keyBytes []byte) (*Key, error) {
@@ -118,3 +130,53 @@ func WriteToJSONFile(path string, key *Key) error {
 	}
 	return nil
 }
+
+// ReadFromYAMLBytes reads IAM Key from YAML bytes.
+func ReadFromYAMLBytes(keyBytes []byte) (*Key, error) {
+	key := &Key{}
+	err := yaml.Unmarshal(keyBytes, key)
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "key unmarshal fail")
+	}
+	return key, nil
+}
+
+// ReadFromYAMLFile reads IAM Key from YAML file.
+func ReadFromYAMLFile(path string) (*Key, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "key file '%s' read fail", path)
+	}
+	return ReadFromYAMLBytes(data)
+}
+
+// WriteToYAMLFile writes key to file in YAML format.
+// File permissions will be 0600, because private key part is sensitive data.
+func WriteToYAMLFile(path string, key *Key) error {
+	data, err := yaml.Marshal(key)
+	if err != nil {
+		return sdkerrors.WithMessage(err, "key marshal fail")
+	}
+	err = ioutil.WriteFile(path, data, 0600)
+	if err != nil {
+		return sdkerrors.WithMessagef(err, "file '%s' write fail", path)
+	}
+	return nil
+}
+
+// ReadFromBytes reads IAM Key from either JSON or YAML bytes.
+func ReadFromBytes(keyBytes []byte) (*Key, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(keyBytes), []byte("{")) {
+		return ReadFromJSONBytes(keyBytes)
+	}
+	return ReadFromYAMLBytes(keyBytes)
+}
+
+// ReadFromFile reads IAM Key from either JSON or YAML file. The format is detected by the file content.
+func ReadFromFile(path string) (*Key, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "key file '%s' read fail", path)
+	}
+	return ReadFromBytes(data)
+}
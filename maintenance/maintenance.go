@@ -0,0 +1,345 @@
+// Package maintenance lists planned maintenance of ClickHouse and Kafka clusters of a project
+// and reschedules it to a policy window:
+//
+//	r, err := maintenance.NewRescheduler(sdk.API(), maintenance.Config{
+//		Window: maintenance.Window{Day: time.Sunday, Hour: 2},
+//		DryRun: true,
+//	})
+//	plan, err := r.Reschedule(ctx, projectID)
+//	fmt.Print(plan.Diff())
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Kind is a kind of cluster.
+type Kind string
+
+const (
+	KindClickHouse Kind = "clickhouse"
+	KindKafka      Kind = "kafka"
+)
+
+// Maintenance is maintenance settings of a cluster.
+type Maintenance struct {
+	Kind        Kind
+	ClusterID   string
+	ClusterName string
+	Status      dcv1.ClusterStatus
+	// Window is the maintenance window of the cluster, nil if not set.
+	Window *dcv1.MaintenanceWindow
+	// Planned is planned maintenance operation, nil if nothing is planned.
+	Planned *dcv1.MaintenanceOperation
+}
+
+// Scheduled returns time of planned maintenance, zero if nothing is planned.
+func (m *Maintenance) Scheduled() time.Time {
+	if t := m.Planned.GetScheduledMaintenanceTime(); t != nil {
+		return t.AsTime()
+	}
+	return time.Time{}
+}
+
+// Deadline returns time planned maintenance can't be delayed after, zero if there is no deadline.
+func (m *Maintenance) Deadline() time.Time {
+	if t := m.Planned.GetDeadlineMaintenanceTime(); t != nil {
+		return t.AsTime()
+	}
+	return time.Time{}
+}
+
+// WindowString describes the maintenance window, e.g. "SUNDAY 02:00 UTC".
+func (m *Maintenance) WindowString() string {
+	switch p := m.Window.GetPolicy().(type) {
+	case *dcv1.MaintenanceWindow_Anytime:
+		return "anytime"
+	case *dcv1.MaintenanceWindow_WeeklyMaintenanceWindow:
+		return fmt.Sprintf("%s %02d:00 UTC", p.WeeklyMaintenanceWindow.GetDay(), p.WeeklyMaintenanceWindow.GetHour())
+	}
+	return "not set"
+}
+
+// List returns maintenance of all ClickHouse and Kafka clusters of the project.
+// Clusters with planned maintenance go first, the earliest first, the others are ordered by name.
+func List(ctx context.Context, api dcsdk.API, projectID string, opts ...grpc.CallOption) ([]*Maintenance, error) {
+	var result []*Maintenance
+	for _, kind := range []Kind{KindClickHouse, KindKafka} {
+		listed, err := ListKind(ctx, api, kind, projectID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, listed...)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		ti, tj := result[i].Scheduled(), result[j].Scheduled()
+		switch {
+		case ti.IsZero() != tj.IsZero():
+			return !ti.IsZero()
+		case !ti.Equal(tj):
+			return ti.Before(tj)
+		}
+		return result[i].ClusterName < result[j].ClusterName
+	})
+	return result, nil
+}
+
+// ListKind returns maintenance of clusters of kind of the project in order they are listed by API.
+func ListKind(ctx context.Context, api dcsdk.API, kind Kind, projectID string, opts ...grpc.CallOption) ([]*Maintenance, error) {
+	var result []*Maintenance
+	var token string
+	for {
+		paging := &dcv1.Paging{PageToken: token}
+		var next *dcv1.NextPage
+		switch kind {
+		case KindClickHouse:
+			resp, err := api.ClickHouse().Cluster().List(ctx, &chv1.ListClustersRequest{ProjectId: projectID, Paging: paging}, opts...)
+			if err != nil {
+				return nil, sdkerrors.WithMessagef(err, "project %s clickhouse clusters list failed", projectID)
+			}
+			for _, c := range resp.Clusters {
+				result = append(result, &Maintenance{
+					Kind:        kind,
+					ClusterID:   c.Id,
+					ClusterName: c.Name,
+					Status:      c.Status,
+					Window:      c.MaintenanceWindow,
+					Planned:     c.MaintenanceOperation,
+				})
+			}
+			next = resp.NextPage
+		case KindKafka:
+			resp, err := api.Kafka().Cluster().List(ctx, &kfv1.ListClustersRequest{ProjectId: projectID, Paging: paging}, opts...)
+			if err != nil {
+				return nil, sdkerrors.WithMessagef(err, "project %s kafka clusters list failed", projectID)
+			}
+			for _, c := range resp.Clusters {
+				result = append(result, &Maintenance{
+					Kind:        kind,
+					ClusterID:   c.Id,
+					ClusterName: c.Name,
+					Status:      c.Status,
+					Window:      c.MaintenanceWindow,
+					Planned:     c.PlannedOperation,
+				})
+			}
+			next = resp.NextPage
+		default:
+			return nil, fmt.Errorf("unknown cluster kind %q", kind)
+		}
+		if token = next.GetToken(); token == "" {
+			return result, nil
+		}
+	}
+}
+
+// Window is a weekly hour maintenance is moved to.
+type Window struct {
+	Day  time.Weekday
+	Hour int
+	// Location is a time zone of the window.
+	// Default value: time.UTC
+	Location *time.Location
+}
+
+func (w Window) location() *time.Location {
+	if w.Location == nil {
+		return time.UTC
+	}
+	return w.Location
+}
+
+// Contains reports whether t is within the window hour.
+func (w Window) Contains(t time.Time) bool {
+	t = t.In(w.location())
+	return t.Weekday() == w.Day && t.Hour() == w.Hour
+}
+
+// Next returns t if it is within the window, otherwise the start of the first window after t.
+func (w Window) Next(t time.Time) time.Time {
+	if w.Contains(t) {
+		return t
+	}
+	t = t.In(w.location())
+	days := (int(w.Day) - int(t.Weekday()) + 7) % 7
+	start := time.Date(t.Year(), t.Month(), t.Day()+days, w.Hour, 0, 0, 0, w.location())
+	if !start.After(t) {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start
+}
+
+func (w Window) String() string {
+	return fmt.Sprintf("%s %02d:00 %s", w.Day, w.Hour, w.location())
+}
+
+// Change is a planned reschedule of cluster maintenance.
+type Change struct {
+	Maintenance *Maintenance
+	From        time.Time
+	// To is zero if maintenance is not moved, see Reason.
+	To time.Time
+	// Reason explains why maintenance is not moved.
+	Reason string
+	// Err is set if reschedule failed.
+	Err error
+}
+
+// Moved reports whether maintenance is moved by the change.
+func (c *Change) Moved() bool {
+	return !c.To.IsZero()
+}
+
+// Plan is a set of changes of maintenance of project clusters.
+type Plan struct {
+	ProjectID string
+	Window    Window
+	Changes   []*Change
+}
+
+// Diff describes the plan line by line: "~" marks moved maintenance, "=" kept one, "!" failed reschedule.
+func (p *Plan) Diff() string {
+	var b strings.Builder
+	for _, c := range p.Changes {
+		m := c.Maintenance
+		cluster := fmt.Sprintf("%s %s (%s)", m.Kind, m.ClusterID, m.ClusterName)
+		from := c.From.UTC().Format(time.RFC3339)
+		switch {
+		case c.Err != nil:
+			fmt.Fprintf(&b, "! %s: %s -> %s: %v\n", cluster, from, c.To.UTC().Format(time.RFC3339), c.Err)
+		case c.Moved():
+			fmt.Fprintf(&b, "~ %s: %s -> %s\n", cluster, from, c.To.UTC().Format(time.RFC3339))
+		default:
+			fmt.Fprintf(&b, "= %s: %s: %s\n", cluster, from, c.Reason)
+		}
+	}
+	return b.String()
+}
+
+// Config configures Rescheduler.
+type Config struct {
+	// Window is the window planned maintenance is moved to.
+	Window Window
+	// DryRun makes Reschedule only plan changes.
+	DryRun bool
+}
+
+// Rescheduler moves planned maintenance of clusters to the policy window.
+type Rescheduler struct {
+	api dcsdk.API
+	cfg Config
+	// now may be replaced in tests
+	now func() time.Time
+}
+
+// NewRescheduler creates Rescheduler that uses api.
+func NewRescheduler(api dcsdk.API, cfg Config) (*Rescheduler, error) {
+	if cfg.Window.Day < time.Sunday || cfg.Window.Day > time.Saturday {
+		return nil, fmt.Errorf("maintenance window: invalid day %d", cfg.Window.Day)
+	}
+	if cfg.Window.Hour < 0 || cfg.Window.Hour > 23 {
+		return nil, fmt.Errorf("maintenance window: hour %d out of range 0-23", cfg.Window.Hour)
+	}
+	return &Rescheduler{api: api, cfg: cfg, now: time.Now}, nil
+}
+
+// Plan computes changes of maintenance planned for clusters of the project.
+// Maintenance can only be delayed, so it is moved to the first window after the time it is planned at.
+// Maintenance that is within the window already, that would be delayed past its deadline, or which time
+// has passed, e.g. it is in progress, is not moved.
+func (r *Rescheduler) Plan(ctx context.Context, projectID string, opts ...grpc.CallOption) (*Plan, error) {
+	list, err := List(ctx, r.api, projectID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{ProjectID: projectID, Window: r.cfg.Window}
+	now := r.now()
+	for _, m := range list {
+		from := m.Scheduled()
+		if from.IsZero() {
+			continue
+		}
+		change := &Change{Maintenance: m, From: from}
+		// Maintenance which time has passed is skipped, so the window is never in the past.
+		to := r.cfg.Window.Next(laterOf(from, now))
+		switch deadline := m.Deadline(); {
+		case !from.After(now):
+			change.Reason = "the time has passed"
+		case to.Equal(from):
+			change.Reason = "within the window"
+		case !deadline.IsZero() && to.After(deadline):
+			change.Reason = fmt.Sprintf("the window is after the deadline %s", deadline.UTC().Format(time.RFC3339))
+		default:
+			change.To = to
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+	return plan, nil
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// Reschedule plans changes and moves maintenance of clusters of the project, waiting for each operation.
+// In dry-run mode nothing is moved. Failed reschedules are recorded in Change.Err, and the first one is returned.
+func (r *Rescheduler) Reschedule(ctx context.Context, projectID string, opts ...grpc.CallOption) (*Plan, error) {
+	plan, err := r.Plan(ctx, projectID, opts...)
+	if err != nil || r.cfg.DryRun {
+		return plan, err
+	}
+	var firstErr error
+	for _, c := range plan.Changes {
+		if !c.Moved() {
+			continue
+		}
+		c.Err = r.reschedule(ctx, c, opts...)
+		if c.Err != nil && firstErr == nil {
+			firstErr = c.Err
+		}
+	}
+	return plan, firstErr
+}
+
+func (r *Rescheduler) reschedule(ctx context.Context, c *Change, opts ...grpc.CallOption) error {
+	m := c.Maintenance
+	until := timestamppb.New(c.To)
+	var op *dcv1.Operation
+	var err error
+	if m.Kind == KindClickHouse {
+		op, err = r.api.ClickHouse().Cluster().RescheduleMaintenance(ctx, &chv1.RescheduleMaintenanceRequest{
+			ClusterId:        m.ClusterID,
+			RescheduleType:   dcv1.RescheduleType_RESCHEDULE_TYPE_SPECIFIC_TIME,
+			DelayedUntilTime: until,
+		}, opts...)
+	} else {
+		op, err = r.api.Kafka().Cluster().RescheduleMaintenance(ctx, &kfv1.RescheduleMaintenanceRequest{
+			ClusterId:        m.ClusterID,
+			RescheduleType:   dcv1.RescheduleType_RESCHEDULE_TYPE_SPECIFIC_TIME,
+			DelayedUntilTime: until,
+		}, opts...)
+	}
+	wrapped, err := r.api.WrapOperation(op, err)
+	if err == nil {
+		err = wrapped.Wait(ctx, opts...)
+	}
+	if err != nil {
+		return sdkerrors.WithMessagef(err, "cluster %s maintenance reschedule failed", m.ClusterID)
+	}
+	return nil
+}
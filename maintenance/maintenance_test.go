@@ -0,0 +1,172 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/dctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/dayofweek"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func date(day, hour, minute int) time.Time {
+	return time.Date(2024, 6, day, hour, minute, 0, 0, time.UTC)
+}
+
+func planned(at, deadline time.Time) *dcv1.MaintenanceOperation {
+	return &dcv1.MaintenanceOperation{
+		Info:                     "update",
+		ScheduledMaintenanceTime: timestamppb.New(at),
+		DeadlineMaintenanceTime:  timestamppb.New(deadline),
+	}
+}
+
+func TestWindow_Next(t *testing.T) {
+	w := Window{Day: time.Sunday, Hour: 2}
+	for from, next := range map[time.Time]time.Time{
+		date(12, 3, 0):  date(16, 2, 0),
+		date(16, 1, 59): date(16, 2, 0),
+		date(16, 2, 30): date(16, 2, 30),
+		date(16, 3, 0):  date(23, 2, 0),
+	} {
+		assert.Equal(t, next, w.Next(from), from)
+	}
+
+	w.Location = time.FixedZone("UTC+3", 3*60*60)
+	assert.Equal(t, date(15, 23, 0), w.Next(date(12, 3, 0)).UTC())
+	assert.Equal(t, "Sunday 02:00 UTC+3", w.String())
+}
+
+type fixture struct {
+	srv *dctest.Server
+	api dcsdk.API
+	ids map[string]string
+}
+
+func newFixture(t *testing.T) *fixture {
+	ctx := context.Background()
+	srv := dctest.NewServer(dctest.Config{})
+	t.Cleanup(srv.Close)
+	sdk, err := srv.SDK(ctx)
+	require.NoError(t, err)
+	f := &fixture{srv: srv, api: sdk.API(), ids: map[string]string{}}
+
+	for name, op := range map[string]*dcv1.MaintenanceOperation{
+		"ch-a": planned(date(12, 3, 0), date(26, 0, 0)),
+		"ch-b": planned(date(16, 2, 30), date(26, 0, 0)),
+		"ch-c": nil,
+	} {
+		op := op
+		wrapped, err := f.api.WrapOperation(f.api.ClickHouse().Cluster().Create(ctx, &chv1.CreateClusterRequest{
+			ProjectId: "p1",
+			Name:      name,
+			MaintenanceWindow: &dcv1.MaintenanceWindow{Policy: &dcv1.MaintenanceWindow_WeeklyMaintenanceWindow{
+				WeeklyMaintenanceWindow: &dcv1.WeeklyMaintenanceWindow{Day: dayofweek.DayOfWeek_SATURDAY, Hour: 4},
+			}},
+		}))
+		require.NoError(t, err)
+		require.NoError(t, wrapped.Wait(ctx))
+		f.ids[name] = wrapped.ResourceId()
+		require.NoError(t, srv.UpdateClickHouseCluster(wrapped.ResourceId(), func(c *chv1.Cluster) { c.MaintenanceOperation = op }))
+	}
+	for name, op := range map[string]*dcv1.MaintenanceOperation{
+		"kafka-a": planned(date(13, 4, 0), date(14, 0, 0)),
+		"kafka-b": planned(date(10, 0, 0), date(24, 0, 0)),
+	} {
+		op := op
+		wrapped, err := f.api.WrapOperation(f.api.Kafka().Cluster().Create(ctx, &kfv1.CreateClusterRequest{ProjectId: "p1", Name: name}))
+		require.NoError(t, err)
+		require.NoError(t, wrapped.Wait(ctx))
+		f.ids[name] = wrapped.ResourceId()
+		require.NoError(t, srv.UpdateKafkaCluster(wrapped.ResourceId(), func(c *kfv1.Cluster) { c.PlannedOperation = op }))
+	}
+	return f
+}
+
+func TestList(t *testing.T) {
+	f := newFixture(t)
+	list, err := List(context.Background(), f.api, "p1")
+	require.NoError(t, err)
+
+	var names []string
+	for _, m := range list {
+		names = append(names, m.ClusterName)
+	}
+	assert.Equal(t, []string{"kafka-b", "ch-a", "kafka-a", "ch-b", "ch-c"}, names)
+	assert.Equal(t, KindKafka, list[0].Kind)
+	assert.Equal(t, date(10, 0, 0), list[0].Scheduled())
+	assert.Equal(t, date(24, 0, 0), list[0].Deadline())
+	assert.Equal(t, "not set", list[0].WindowString())
+	assert.True(t, list[4].Scheduled().IsZero())
+	assert.Equal(t, "SATURDAY 04:00 UTC", list[4].WindowString())
+}
+
+func TestRescheduler(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	cfg := Config{Window: Window{Day: time.Sunday, Hour: 2}, DryRun: true}
+	r, err := NewRescheduler(f.api, cfg)
+	require.NoError(t, err)
+	r.now = func() time.Time { return date(1, 0, 0) }
+
+	plan, err := r.Reschedule(ctx, "p1")
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"~ kafka "+f.ids["kafka-b"]+" (kafka-b): 2024-06-10T00:00:00Z -> 2024-06-16T02:00:00Z\n"+
+		"~ clickhouse "+f.ids["ch-a"]+" (ch-a): 2024-06-12T03:00:00Z -> 2024-06-16T02:00:00Z\n"+
+		"= kafka "+f.ids["kafka-a"]+" (kafka-a): 2024-06-13T04:00:00Z: the window is after the deadline 2024-06-14T00:00:00Z\n"+
+		"= clickhouse "+f.ids["ch-b"]+" (ch-b): 2024-06-16T02:30:00Z: within the window\n",
+		plan.Diff())
+	assert.Zero(t, f.srv.Calls(chv1.ClusterService_RescheduleMaintenance_FullMethodName))
+	assert.Zero(t, f.srv.Calls(kfv1.ClusterService_RescheduleMaintenance_FullMethodName))
+
+	f.srv.InjectFault(dctest.Fault{Method: kfv1.ClusterService_RescheduleMaintenance_FullMethodName, Times: 1, Code: codes.Unavailable})
+	cfg.DryRun = false
+	r, err = NewRescheduler(f.api, cfg)
+	require.NoError(t, err)
+	r.now = func() time.Time { return date(1, 0, 0) }
+	plan, err = r.Reschedule(ctx, "p1")
+	require.Error(t, err)
+	assert.Error(t, plan.Changes[0].Err)
+	assert.Contains(t, plan.Diff(), "! kafka "+f.ids["kafka-b"])
+	require.NoError(t, plan.Changes[1].Err)
+
+	plan, err = r.Reschedule(ctx, "p1")
+	require.NoError(t, err)
+	for _, c := range plan.Changes {
+		// Only the failed reschedule is retried, moved maintenance is within the window now.
+		assert.Equal(t, c.Maintenance.ClusterName == "kafka-b", c.Moved(), c.Maintenance.ClusterName)
+	}
+	list, err := List(ctx, f.api, "p1")
+	require.NoError(t, err)
+	for _, m := range list {
+		switch m.ClusterName {
+		case "ch-a", "kafka-b":
+			assert.Equal(t, date(16, 2, 0), m.Scheduled(), m.ClusterName)
+		case "kafka-a":
+			assert.Equal(t, date(13, 4, 0), m.Scheduled(), m.ClusterName)
+		}
+	}
+
+	// Maintenance which time has passed is not moved.
+	r.now = func() time.Time { return date(16, 2, 15) }
+	plan, err = r.Plan(ctx, "p1")
+	require.NoError(t, err)
+	for _, c := range plan.Changes {
+		assert.False(t, c.Moved(), c.Maintenance.ClusterName)
+		if c.Maintenance.ClusterName != "ch-b" {
+			assert.Equal(t, "the time has passed", c.Reason, c.Maintenance.ClusterName)
+		}
+	}
+
+	_, err = NewRescheduler(f.api, Config{Window: Window{Day: time.Sunday, Hour: 24}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,426 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	mock "github.com/stretchr/testify/mock"
+
+	operation "github.com/doublecloud/go-sdk/operation"
+)
+
+// API is an autogenerated mock type for the API type
+type API struct {
+	mock.Mock
+}
+
+type API_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *API) EXPECT() *API_Expecter {
+	return &API_Expecter{mock: &_m.Mock}
+}
+
+// ClickHouse provides a mock function with no fields
+func (_m *API) ClickHouse() dcsdk.ClickHouseAPI {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClickHouse")
+	}
+
+	var r0 dcsdk.ClickHouseAPI
+	if rf, ok := ret.Get(0).(func() dcsdk.ClickHouseAPI); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dcsdk.ClickHouseAPI)
+		}
+	}
+
+	return r0
+}
+
+// API_ClickHouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClickHouse'
+type API_ClickHouse_Call struct {
+	*mock.Call
+}
+
+// ClickHouse is a helper method to define mock.On call
+func (_e *API_Expecter) ClickHouse() *API_ClickHouse_Call {
+	return &API_ClickHouse_Call{Call: _e.mock.On("ClickHouse")}
+}
+
+func (_c *API_ClickHouse_Call) Run(run func()) *API_ClickHouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *API_ClickHouse_Call) Return(_a0 dcsdk.ClickHouseAPI) *API_ClickHouse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_ClickHouse_Call) RunAndReturn(run func() dcsdk.ClickHouseAPI) *API_ClickHouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Kafka provides a mock function with no fields
+func (_m *API) Kafka() dcsdk.KafkaAPI {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Kafka")
+	}
+
+	var r0 dcsdk.KafkaAPI
+	if rf, ok := ret.Get(0).(func() dcsdk.KafkaAPI); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dcsdk.KafkaAPI)
+		}
+	}
+
+	return r0
+}
+
+// API_Kafka_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Kafka'
+type API_Kafka_Call struct {
+	*mock.Call
+}
+
+// Kafka is a helper method to define mock.On call
+func (_e *API_Expecter) Kafka() *API_Kafka_Call {
+	return &API_Kafka_Call{Call: _e.mock.On("Kafka")}
+}
+
+func (_c *API_Kafka_Call) Run(run func()) *API_Kafka_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *API_Kafka_Call) Return(_a0 dcsdk.KafkaAPI) *API_Kafka_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_Kafka_Call) RunAndReturn(run func() dcsdk.KafkaAPI) *API_Kafka_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logs provides a mock function with no fields
+func (_m *API) Logs() dcsdk.LogsAPI {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logs")
+	}
+
+	var r0 dcsdk.LogsAPI
+	if rf, ok := ret.Get(0).(func() dcsdk.LogsAPI); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dcsdk.LogsAPI)
+		}
+	}
+
+	return r0
+}
+
+// API_Logs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logs'
+type API_Logs_Call struct {
+	*mock.Call
+}
+
+// Logs is a helper method to define mock.On call
+func (_e *API_Expecter) Logs() *API_Logs_Call {
+	return &API_Logs_Call{Call: _e.mock.On("Logs")}
+}
+
+func (_c *API_Logs_Call) Run(run func()) *API_Logs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *API_Logs_Call) Return(_a0 dcsdk.LogsAPI) *API_Logs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_Logs_Call) RunAndReturn(run func() dcsdk.LogsAPI) *API_Logs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Network provides a mock function with no fields
+func (_m *API) Network() dcsdk.NetworkAPI {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Network")
+	}
+
+	var r0 dcsdk.NetworkAPI
+	if rf, ok := ret.Get(0).(func() dcsdk.NetworkAPI); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dcsdk.NetworkAPI)
+		}
+	}
+
+	return r0
+}
+
+// API_Network_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Network'
+type API_Network_Call struct {
+	*mock.Call
+}
+
+// Network is a helper method to define mock.On call
+func (_e *API_Expecter) Network() *API_Network_Call {
+	return &API_Network_Call{Call: _e.mock.On("Network")}
+}
+
+func (_c *API_Network_Call) Run(run func()) *API_Network_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *API_Network_Call) Return(_a0 dcsdk.NetworkAPI) *API_Network_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_Network_Call) RunAndReturn(run func() dcsdk.NetworkAPI) *API_Network_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Organization provides a mock function with no fields
+func (_m *API) Organization() dcsdk.OrganizationAPI {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Organization")
+	}
+
+	var r0 dcsdk.OrganizationAPI
+	if rf, ok := ret.Get(0).(func() dcsdk.OrganizationAPI); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dcsdk.OrganizationAPI)
+		}
+	}
+
+	return r0
+}
+
+// API_Organization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Organization'
+type API_Organization_Call struct {
+	*mock.Call
+}
+
+// Organization is a helper method to define mock.On call
+func (_e *API_Expecter) Organization() *API_Organization_Call {
+	return &API_Organization_Call{Call: _e.mock.On("Organization")}
+}
+
+func (_c *API_Organization_Call) Run(run func()) *API_Organization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *API_Organization_Call) Return(_a0 dcsdk.OrganizationAPI) *API_Organization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_Organization_Call) RunAndReturn(run func() dcsdk.OrganizationAPI) *API_Organization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transfer provides a mock function with no fields
+func (_m *API) Transfer() dcsdk.TransferAPI {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transfer")
+	}
+
+	var r0 dcsdk.TransferAPI
+	if rf, ok := ret.Get(0).(func() dcsdk.TransferAPI); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dcsdk.TransferAPI)
+		}
+	}
+
+	return r0
+}
+
+// API_Transfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transfer'
+type API_Transfer_Call struct {
+	*mock.Call
+}
+
+// Transfer is a helper method to define mock.On call
+func (_e *API_Expecter) Transfer() *API_Transfer_Call {
+	return &API_Transfer_Call{Call: _e.mock.On("Transfer")}
+}
+
+func (_c *API_Transfer_Call) Run(run func()) *API_Transfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *API_Transfer_Call) Return(_a0 dcsdk.TransferAPI) *API_Transfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_Transfer_Call) RunAndReturn(run func() dcsdk.TransferAPI) *API_Transfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Visualization provides a mock function with no fields
+func (_m *API) Visualization() dcsdk.VisualizationAPI {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Visualization")
+	}
+
+	var r0 dcsdk.VisualizationAPI
+	if rf, ok := ret.Get(0).(func() dcsdk.VisualizationAPI); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dcsdk.VisualizationAPI)
+		}
+	}
+
+	return r0
+}
+
+// API_Visualization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Visualization'
+type API_Visualization_Call struct {
+	*mock.Call
+}
+
+// Visualization is a helper method to define mock.On call
+func (_e *API_Expecter) Visualization() *API_Visualization_Call {
+	return &API_Visualization_Call{Call: _e.mock.On("Visualization")}
+}
+
+func (_c *API_Visualization_Call) Run(run func()) *API_Visualization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *API_Visualization_Call) Return(_a0 dcsdk.VisualizationAPI) *API_Visualization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_Visualization_Call) RunAndReturn(run func() dcsdk.VisualizationAPI) *API_Visualization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WrapOperation provides a mock function with given fields: o, err
+func (_m *API) WrapOperation(o *doublecloud.Operation, err error) (*operation.Operation, error) {
+	ret := _m.Called(o, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WrapOperation")
+	}
+
+	var r0 *operation.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*doublecloud.Operation, error) (*operation.Operation, error)); ok {
+		return rf(o, err)
+	}
+	if rf, ok := ret.Get(0).(func(*doublecloud.Operation, error) *operation.Operation); ok {
+		r0 = rf(o, err)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operation.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*doublecloud.Operation, error) error); ok {
+		r1 = rf(o, err)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_WrapOperation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WrapOperation'
+type API_WrapOperation_Call struct {
+	*mock.Call
+}
+
+// WrapOperation is a helper method to define mock.On call
+//   - o *doublecloud.Operation
+//   - err error
+func (_e *API_Expecter) WrapOperation(o interface{}, err interface{}) *API_WrapOperation_Call {
+	return &API_WrapOperation_Call{Call: _e.mock.On("WrapOperation", o, err)}
+}
+
+func (_c *API_WrapOperation_Call) Run(run func(o *doublecloud.Operation, err error)) *API_WrapOperation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*doublecloud.Operation), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *API_WrapOperation_Call) Return(_a0 *operation.Operation, _a1 error) *API_WrapOperation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_WrapOperation_Call) RunAndReturn(run func(*doublecloud.Operation, error) (*operation.Operation, error)) *API_WrapOperation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPI creates a new instance of API. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *API {
+	mock := &API{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
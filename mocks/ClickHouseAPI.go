@@ -0,0 +1,224 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	clickhouse "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ClickHouseAPI is an autogenerated mock type for the ClickHouseAPI type
+type ClickHouseAPI struct {
+	mock.Mock
+}
+
+type ClickHouseAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClickHouseAPI) EXPECT() *ClickHouseAPI_Expecter {
+	return &ClickHouseAPI_Expecter{mock: &_m.Mock}
+}
+
+// Backup provides a mock function with no fields
+func (_m *ClickHouseAPI) Backup() clickhouse.BackupServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backup")
+	}
+
+	var r0 clickhouse.BackupServiceClient
+	if rf, ok := ret.Get(0).(func() clickhouse.BackupServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clickhouse.BackupServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// ClickHouseAPI_Backup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backup'
+type ClickHouseAPI_Backup_Call struct {
+	*mock.Call
+}
+
+// Backup is a helper method to define mock.On call
+func (_e *ClickHouseAPI_Expecter) Backup() *ClickHouseAPI_Backup_Call {
+	return &ClickHouseAPI_Backup_Call{Call: _e.mock.On("Backup")}
+}
+
+func (_c *ClickHouseAPI_Backup_Call) Run(run func()) *ClickHouseAPI_Backup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ClickHouseAPI_Backup_Call) Return(_a0 clickhouse.BackupServiceClient) *ClickHouseAPI_Backup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClickHouseAPI_Backup_Call) RunAndReturn(run func() clickhouse.BackupServiceClient) *ClickHouseAPI_Backup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cluster provides a mock function with no fields
+func (_m *ClickHouseAPI) Cluster() clickhouse.ClusterServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cluster")
+	}
+
+	var r0 clickhouse.ClusterServiceClient
+	if rf, ok := ret.Get(0).(func() clickhouse.ClusterServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clickhouse.ClusterServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// ClickHouseAPI_Cluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cluster'
+type ClickHouseAPI_Cluster_Call struct {
+	*mock.Call
+}
+
+// Cluster is a helper method to define mock.On call
+func (_e *ClickHouseAPI_Expecter) Cluster() *ClickHouseAPI_Cluster_Call {
+	return &ClickHouseAPI_Cluster_Call{Call: _e.mock.On("Cluster")}
+}
+
+func (_c *ClickHouseAPI_Cluster_Call) Run(run func()) *ClickHouseAPI_Cluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ClickHouseAPI_Cluster_Call) Return(_a0 clickhouse.ClusterServiceClient) *ClickHouseAPI_Cluster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClickHouseAPI_Cluster_Call) RunAndReturn(run func() clickhouse.ClusterServiceClient) *ClickHouseAPI_Cluster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Operation provides a mock function with no fields
+func (_m *ClickHouseAPI) Operation() clickhouse.OperationServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Operation")
+	}
+
+	var r0 clickhouse.OperationServiceClient
+	if rf, ok := ret.Get(0).(func() clickhouse.OperationServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clickhouse.OperationServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// ClickHouseAPI_Operation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Operation'
+type ClickHouseAPI_Operation_Call struct {
+	*mock.Call
+}
+
+// Operation is a helper method to define mock.On call
+func (_e *ClickHouseAPI_Expecter) Operation() *ClickHouseAPI_Operation_Call {
+	return &ClickHouseAPI_Operation_Call{Call: _e.mock.On("Operation")}
+}
+
+func (_c *ClickHouseAPI_Operation_Call) Run(run func()) *ClickHouseAPI_Operation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ClickHouseAPI_Operation_Call) Return(_a0 clickhouse.OperationServiceClient) *ClickHouseAPI_Operation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClickHouseAPI_Operation_Call) RunAndReturn(run func() clickhouse.OperationServiceClient) *ClickHouseAPI_Operation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Version provides a mock function with no fields
+func (_m *ClickHouseAPI) Version() clickhouse.VersionServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 clickhouse.VersionServiceClient
+	if rf, ok := ret.Get(0).(func() clickhouse.VersionServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clickhouse.VersionServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// ClickHouseAPI_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type ClickHouseAPI_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+func (_e *ClickHouseAPI_Expecter) Version() *ClickHouseAPI_Version_Call {
+	return &ClickHouseAPI_Version_Call{Call: _e.mock.On("Version")}
+}
+
+func (_c *ClickHouseAPI_Version_Call) Run(run func()) *ClickHouseAPI_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ClickHouseAPI_Version_Call) Return(_a0 clickhouse.VersionServiceClient) *ClickHouseAPI_Version_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClickHouseAPI_Version_Call) RunAndReturn(run func() clickhouse.VersionServiceClient) *ClickHouseAPI_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClickHouseAPI creates a new instance of ClickHouseAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClickHouseAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClickHouseAPI {
+	mock := &ClickHouseAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
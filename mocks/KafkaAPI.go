@@ -0,0 +1,270 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	kafka "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// KafkaAPI is an autogenerated mock type for the KafkaAPI type
+type KafkaAPI struct {
+	mock.Mock
+}
+
+type KafkaAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KafkaAPI) EXPECT() *KafkaAPI_Expecter {
+	return &KafkaAPI_Expecter{mock: &_m.Mock}
+}
+
+// Cluster provides a mock function with no fields
+func (_m *KafkaAPI) Cluster() kafka.ClusterServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cluster")
+	}
+
+	var r0 kafka.ClusterServiceClient
+	if rf, ok := ret.Get(0).(func() kafka.ClusterServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kafka.ClusterServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// KafkaAPI_Cluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cluster'
+type KafkaAPI_Cluster_Call struct {
+	*mock.Call
+}
+
+// Cluster is a helper method to define mock.On call
+func (_e *KafkaAPI_Expecter) Cluster() *KafkaAPI_Cluster_Call {
+	return &KafkaAPI_Cluster_Call{Call: _e.mock.On("Cluster")}
+}
+
+func (_c *KafkaAPI_Cluster_Call) Run(run func()) *KafkaAPI_Cluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KafkaAPI_Cluster_Call) Return(_a0 kafka.ClusterServiceClient) *KafkaAPI_Cluster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KafkaAPI_Cluster_Call) RunAndReturn(run func() kafka.ClusterServiceClient) *KafkaAPI_Cluster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Operation provides a mock function with no fields
+func (_m *KafkaAPI) Operation() kafka.OperationServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Operation")
+	}
+
+	var r0 kafka.OperationServiceClient
+	if rf, ok := ret.Get(0).(func() kafka.OperationServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kafka.OperationServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// KafkaAPI_Operation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Operation'
+type KafkaAPI_Operation_Call struct {
+	*mock.Call
+}
+
+// Operation is a helper method to define mock.On call
+func (_e *KafkaAPI_Expecter) Operation() *KafkaAPI_Operation_Call {
+	return &KafkaAPI_Operation_Call{Call: _e.mock.On("Operation")}
+}
+
+func (_c *KafkaAPI_Operation_Call) Run(run func()) *KafkaAPI_Operation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KafkaAPI_Operation_Call) Return(_a0 kafka.OperationServiceClient) *KafkaAPI_Operation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KafkaAPI_Operation_Call) RunAndReturn(run func() kafka.OperationServiceClient) *KafkaAPI_Operation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Topic provides a mock function with no fields
+func (_m *KafkaAPI) Topic() kafka.TopicServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Topic")
+	}
+
+	var r0 kafka.TopicServiceClient
+	if rf, ok := ret.Get(0).(func() kafka.TopicServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kafka.TopicServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// KafkaAPI_Topic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Topic'
+type KafkaAPI_Topic_Call struct {
+	*mock.Call
+}
+
+// Topic is a helper method to define mock.On call
+func (_e *KafkaAPI_Expecter) Topic() *KafkaAPI_Topic_Call {
+	return &KafkaAPI_Topic_Call{Call: _e.mock.On("Topic")}
+}
+
+func (_c *KafkaAPI_Topic_Call) Run(run func()) *KafkaAPI_Topic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KafkaAPI_Topic_Call) Return(_a0 kafka.TopicServiceClient) *KafkaAPI_Topic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KafkaAPI_Topic_Call) RunAndReturn(run func() kafka.TopicServiceClient) *KafkaAPI_Topic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// User provides a mock function with no fields
+func (_m *KafkaAPI) User() kafka.UserServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for User")
+	}
+
+	var r0 kafka.UserServiceClient
+	if rf, ok := ret.Get(0).(func() kafka.UserServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kafka.UserServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// KafkaAPI_User_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'User'
+type KafkaAPI_User_Call struct {
+	*mock.Call
+}
+
+// User is a helper method to define mock.On call
+func (_e *KafkaAPI_Expecter) User() *KafkaAPI_User_Call {
+	return &KafkaAPI_User_Call{Call: _e.mock.On("User")}
+}
+
+func (_c *KafkaAPI_User_Call) Run(run func()) *KafkaAPI_User_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KafkaAPI_User_Call) Return(_a0 kafka.UserServiceClient) *KafkaAPI_User_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KafkaAPI_User_Call) RunAndReturn(run func() kafka.UserServiceClient) *KafkaAPI_User_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Version provides a mock function with no fields
+func (_m *KafkaAPI) Version() kafka.VersionServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 kafka.VersionServiceClient
+	if rf, ok := ret.Get(0).(func() kafka.VersionServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kafka.VersionServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// KafkaAPI_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type KafkaAPI_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+func (_e *KafkaAPI_Expecter) Version() *KafkaAPI_Version_Call {
+	return &KafkaAPI_Version_Call{Call: _e.mock.On("Version")}
+}
+
+func (_c *KafkaAPI_Version_Call) Run(run func()) *KafkaAPI_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KafkaAPI_Version_Call) Return(_a0 kafka.VersionServiceClient) *KafkaAPI_Version_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KafkaAPI_Version_Call) RunAndReturn(run func() kafka.VersionServiceClient) *KafkaAPI_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKafkaAPI creates a new instance of KafkaAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKafkaAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KafkaAPI {
+	mock := &KafkaAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,129 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	logs "github.com/doublecloud/go-genproto/doublecloud/logs/v1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LogsAPI is an autogenerated mock type for the LogsAPI type
+type LogsAPI struct {
+	mock.Mock
+}
+
+type LogsAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LogsAPI) EXPECT() *LogsAPI_Expecter {
+	return &LogsAPI_Expecter{mock: &_m.Mock}
+}
+
+// Export provides a mock function with no fields
+func (_m *LogsAPI) Export() logs.LogExportServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 logs.LogExportServiceClient
+	if rf, ok := ret.Get(0).(func() logs.LogExportServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logs.LogExportServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// LogsAPI_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type LogsAPI_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+func (_e *LogsAPI_Expecter) Export() *LogsAPI_Export_Call {
+	return &LogsAPI_Export_Call{Call: _e.mock.On("Export")}
+}
+
+func (_c *LogsAPI_Export_Call) Run(run func()) *LogsAPI_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LogsAPI_Export_Call) Return(_a0 logs.LogExportServiceClient) *LogsAPI_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LogsAPI_Export_Call) RunAndReturn(run func() logs.LogExportServiceClient) *LogsAPI_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Operation provides a mock function with no fields
+func (_m *LogsAPI) Operation() logs.OperationServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Operation")
+	}
+
+	var r0 logs.OperationServiceClient
+	if rf, ok := ret.Get(0).(func() logs.OperationServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logs.OperationServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// LogsAPI_Operation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Operation'
+type LogsAPI_Operation_Call struct {
+	*mock.Call
+}
+
+// Operation is a helper method to define mock.On call
+func (_e *LogsAPI_Expecter) Operation() *LogsAPI_Operation_Call {
+	return &LogsAPI_Operation_Call{Call: _e.mock.On("Operation")}
+}
+
+func (_c *LogsAPI_Operation_Call) Run(run func()) *LogsAPI_Operation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LogsAPI_Operation_Call) Return(_a0 logs.OperationServiceClient) *LogsAPI_Operation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LogsAPI_Operation_Call) RunAndReturn(run func() logs.OperationServiceClient) *LogsAPI_Operation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLogsAPI creates a new instance of LogsAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLogsAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LogsAPI {
+	mock := &LogsAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
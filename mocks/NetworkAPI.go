@@ -0,0 +1,176 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	network "github.com/doublecloud/go-genproto/doublecloud/network/v1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NetworkAPI is an autogenerated mock type for the NetworkAPI type
+type NetworkAPI struct {
+	mock.Mock
+}
+
+type NetworkAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NetworkAPI) EXPECT() *NetworkAPI_Expecter {
+	return &NetworkAPI_Expecter{mock: &_m.Mock}
+}
+
+// Network provides a mock function with no fields
+func (_m *NetworkAPI) Network() network.NetworkServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Network")
+	}
+
+	var r0 network.NetworkServiceClient
+	if rf, ok := ret.Get(0).(func() network.NetworkServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(network.NetworkServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// NetworkAPI_Network_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Network'
+type NetworkAPI_Network_Call struct {
+	*mock.Call
+}
+
+// Network is a helper method to define mock.On call
+func (_e *NetworkAPI_Expecter) Network() *NetworkAPI_Network_Call {
+	return &NetworkAPI_Network_Call{Call: _e.mock.On("Network")}
+}
+
+func (_c *NetworkAPI_Network_Call) Run(run func()) *NetworkAPI_Network_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_Network_Call) Return(_a0 network.NetworkServiceClient) *NetworkAPI_Network_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_Network_Call) RunAndReturn(run func() network.NetworkServiceClient) *NetworkAPI_Network_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NetworkConnection provides a mock function with no fields
+func (_m *NetworkAPI) NetworkConnection() network.NetworkConnectionServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NetworkConnection")
+	}
+
+	var r0 network.NetworkConnectionServiceClient
+	if rf, ok := ret.Get(0).(func() network.NetworkConnectionServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(network.NetworkConnectionServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// NetworkAPI_NetworkConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NetworkConnection'
+type NetworkAPI_NetworkConnection_Call struct {
+	*mock.Call
+}
+
+// NetworkConnection is a helper method to define mock.On call
+func (_e *NetworkAPI_Expecter) NetworkConnection() *NetworkAPI_NetworkConnection_Call {
+	return &NetworkAPI_NetworkConnection_Call{Call: _e.mock.On("NetworkConnection")}
+}
+
+func (_c *NetworkAPI_NetworkConnection_Call) Run(run func()) *NetworkAPI_NetworkConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_NetworkConnection_Call) Return(_a0 network.NetworkConnectionServiceClient) *NetworkAPI_NetworkConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_NetworkConnection_Call) RunAndReturn(run func() network.NetworkConnectionServiceClient) *NetworkAPI_NetworkConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Operation provides a mock function with no fields
+func (_m *NetworkAPI) Operation() network.OperationServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Operation")
+	}
+
+	var r0 network.OperationServiceClient
+	if rf, ok := ret.Get(0).(func() network.OperationServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(network.OperationServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// NetworkAPI_Operation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Operation'
+type NetworkAPI_Operation_Call struct {
+	*mock.Call
+}
+
+// Operation is a helper method to define mock.On call
+func (_e *NetworkAPI_Expecter) Operation() *NetworkAPI_Operation_Call {
+	return &NetworkAPI_Operation_Call{Call: _e.mock.On("Operation")}
+}
+
+func (_c *NetworkAPI_Operation_Call) Run(run func()) *NetworkAPI_Operation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_Operation_Call) Return(_a0 network.OperationServiceClient) *NetworkAPI_Operation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_Operation_Call) RunAndReturn(run func() network.OperationServiceClient) *NetworkAPI_Operation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNetworkAPI creates a new instance of NetworkAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNetworkAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NetworkAPI {
+	mock := &NetworkAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
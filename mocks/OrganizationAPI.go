@@ -0,0 +1,177 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	saml "github.com/doublecloud/go-genproto/doublecloud/organizationmanager/saml/v1"
+	organizationmanager "github.com/doublecloud/go-genproto/doublecloud/organizationmanager/v1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrganizationAPI is an autogenerated mock type for the OrganizationAPI type
+type OrganizationAPI struct {
+	mock.Mock
+}
+
+type OrganizationAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrganizationAPI) EXPECT() *OrganizationAPI_Expecter {
+	return &OrganizationAPI_Expecter{mock: &_m.Mock}
+}
+
+// Group provides a mock function with no fields
+func (_m *OrganizationAPI) Group() organizationmanager.GroupServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Group")
+	}
+
+	var r0 organizationmanager.GroupServiceClient
+	if rf, ok := ret.Get(0).(func() organizationmanager.GroupServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(organizationmanager.GroupServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// OrganizationAPI_Group_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Group'
+type OrganizationAPI_Group_Call struct {
+	*mock.Call
+}
+
+// Group is a helper method to define mock.On call
+func (_e *OrganizationAPI_Expecter) Group() *OrganizationAPI_Group_Call {
+	return &OrganizationAPI_Group_Call{Call: _e.mock.On("Group")}
+}
+
+func (_c *OrganizationAPI_Group_Call) Run(run func()) *OrganizationAPI_Group_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrganizationAPI_Group_Call) Return(_a0 organizationmanager.GroupServiceClient) *OrganizationAPI_Group_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationAPI_Group_Call) RunAndReturn(run func() organizationmanager.GroupServiceClient) *OrganizationAPI_Group_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupMapping provides a mock function with no fields
+func (_m *OrganizationAPI) GroupMapping() organizationmanager.GroupMappingServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupMapping")
+	}
+
+	var r0 organizationmanager.GroupMappingServiceClient
+	if rf, ok := ret.Get(0).(func() organizationmanager.GroupMappingServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(organizationmanager.GroupMappingServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// OrganizationAPI_GroupMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupMapping'
+type OrganizationAPI_GroupMapping_Call struct {
+	*mock.Call
+}
+
+// GroupMapping is a helper method to define mock.On call
+func (_e *OrganizationAPI_Expecter) GroupMapping() *OrganizationAPI_GroupMapping_Call {
+	return &OrganizationAPI_GroupMapping_Call{Call: _e.mock.On("GroupMapping")}
+}
+
+func (_c *OrganizationAPI_GroupMapping_Call) Run(run func()) *OrganizationAPI_GroupMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrganizationAPI_GroupMapping_Call) Return(_a0 organizationmanager.GroupMappingServiceClient) *OrganizationAPI_GroupMapping_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationAPI_GroupMapping_Call) RunAndReturn(run func() organizationmanager.GroupMappingServiceClient) *OrganizationAPI_GroupMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SamlFederation provides a mock function with no fields
+func (_m *OrganizationAPI) SamlFederation() saml.FederationServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SamlFederation")
+	}
+
+	var r0 saml.FederationServiceClient
+	if rf, ok := ret.Get(0).(func() saml.FederationServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(saml.FederationServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// OrganizationAPI_SamlFederation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SamlFederation'
+type OrganizationAPI_SamlFederation_Call struct {
+	*mock.Call
+}
+
+// SamlFederation is a helper method to define mock.On call
+func (_e *OrganizationAPI_Expecter) SamlFederation() *OrganizationAPI_SamlFederation_Call {
+	return &OrganizationAPI_SamlFederation_Call{Call: _e.mock.On("SamlFederation")}
+}
+
+func (_c *OrganizationAPI_SamlFederation_Call) Run(run func()) *OrganizationAPI_SamlFederation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrganizationAPI_SamlFederation_Call) Return(_a0 saml.FederationServiceClient) *OrganizationAPI_SamlFederation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationAPI_SamlFederation_Call) RunAndReturn(run func() saml.FederationServiceClient) *OrganizationAPI_SamlFederation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrganizationAPI creates a new instance of OrganizationAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrganizationAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrganizationAPI {
+	mock := &OrganizationAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
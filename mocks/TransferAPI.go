@@ -0,0 +1,176 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	transfer "github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TransferAPI is an autogenerated mock type for the TransferAPI type
+type TransferAPI struct {
+	mock.Mock
+}
+
+type TransferAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransferAPI) EXPECT() *TransferAPI_Expecter {
+	return &TransferAPI_Expecter{mock: &_m.Mock}
+}
+
+// Endpoint provides a mock function with no fields
+func (_m *TransferAPI) Endpoint() transfer.EndpointServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Endpoint")
+	}
+
+	var r0 transfer.EndpointServiceClient
+	if rf, ok := ret.Get(0).(func() transfer.EndpointServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(transfer.EndpointServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// TransferAPI_Endpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Endpoint'
+type TransferAPI_Endpoint_Call struct {
+	*mock.Call
+}
+
+// Endpoint is a helper method to define mock.On call
+func (_e *TransferAPI_Expecter) Endpoint() *TransferAPI_Endpoint_Call {
+	return &TransferAPI_Endpoint_Call{Call: _e.mock.On("Endpoint")}
+}
+
+func (_c *TransferAPI_Endpoint_Call) Run(run func()) *TransferAPI_Endpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransferAPI_Endpoint_Call) Return(_a0 transfer.EndpointServiceClient) *TransferAPI_Endpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransferAPI_Endpoint_Call) RunAndReturn(run func() transfer.EndpointServiceClient) *TransferAPI_Endpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Operation provides a mock function with no fields
+func (_m *TransferAPI) Operation() transfer.OperationServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Operation")
+	}
+
+	var r0 transfer.OperationServiceClient
+	if rf, ok := ret.Get(0).(func() transfer.OperationServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(transfer.OperationServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// TransferAPI_Operation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Operation'
+type TransferAPI_Operation_Call struct {
+	*mock.Call
+}
+
+// Operation is a helper method to define mock.On call
+func (_e *TransferAPI_Expecter) Operation() *TransferAPI_Operation_Call {
+	return &TransferAPI_Operation_Call{Call: _e.mock.On("Operation")}
+}
+
+func (_c *TransferAPI_Operation_Call) Run(run func()) *TransferAPI_Operation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransferAPI_Operation_Call) Return(_a0 transfer.OperationServiceClient) *TransferAPI_Operation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransferAPI_Operation_Call) RunAndReturn(run func() transfer.OperationServiceClient) *TransferAPI_Operation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transfer provides a mock function with no fields
+func (_m *TransferAPI) Transfer() transfer.TransferServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transfer")
+	}
+
+	var r0 transfer.TransferServiceClient
+	if rf, ok := ret.Get(0).(func() transfer.TransferServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(transfer.TransferServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// TransferAPI_Transfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transfer'
+type TransferAPI_Transfer_Call struct {
+	*mock.Call
+}
+
+// Transfer is a helper method to define mock.On call
+func (_e *TransferAPI_Expecter) Transfer() *TransferAPI_Transfer_Call {
+	return &TransferAPI_Transfer_Call{Call: _e.mock.On("Transfer")}
+}
+
+func (_c *TransferAPI_Transfer_Call) Run(run func()) *TransferAPI_Transfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransferAPI_Transfer_Call) Return(_a0 transfer.TransferServiceClient) *TransferAPI_Transfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransferAPI_Transfer_Call) RunAndReturn(run func() transfer.TransferServiceClient) *TransferAPI_Transfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransferAPI creates a new instance of TransferAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransferAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransferAPI {
+	mock := &TransferAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
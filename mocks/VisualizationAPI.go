@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	visualization "github.com/doublecloud/go-genproto/doublecloud/visualization/v1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// VisualizationAPI is an autogenerated mock type for the VisualizationAPI type
+type VisualizationAPI struct {
+	mock.Mock
+}
+
+type VisualizationAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VisualizationAPI) EXPECT() *VisualizationAPI_Expecter {
+	return &VisualizationAPI_Expecter{mock: &_m.Mock}
+}
+
+// Workbook provides a mock function with no fields
+func (_m *VisualizationAPI) Workbook() visualization.WorkbookServiceClient {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Workbook")
+	}
+
+	var r0 visualization.WorkbookServiceClient
+	if rf, ok := ret.Get(0).(func() visualization.WorkbookServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(visualization.WorkbookServiceClient)
+		}
+	}
+
+	return r0
+}
+
+// VisualizationAPI_Workbook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Workbook'
+type VisualizationAPI_Workbook_Call struct {
+	*mock.Call
+}
+
+// Workbook is a helper method to define mock.On call
+func (_e *VisualizationAPI_Expecter) Workbook() *VisualizationAPI_Workbook_Call {
+	return &VisualizationAPI_Workbook_Call{Call: _e.mock.On("Workbook")}
+}
+
+func (_c *VisualizationAPI_Workbook_Call) Run(run func()) *VisualizationAPI_Workbook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *VisualizationAPI_Workbook_Call) Return(_a0 visualization.WorkbookServiceClient) *VisualizationAPI_Workbook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VisualizationAPI_Workbook_Call) RunAndReturn(run func() visualization.WorkbookServiceClient) *VisualizationAPI_Workbook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVisualizationAPI creates a new instance of VisualizationAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVisualizationAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VisualizationAPI {
+	mock := &VisualizationAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
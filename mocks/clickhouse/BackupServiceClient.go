@@ -0,0 +1,338 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package clickhouse
+
+import (
+	context "context"
+
+	clickhouse "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BackupServiceClient is an autogenerated mock type for the BackupServiceClient type
+type BackupServiceClient struct {
+	mock.Mock
+}
+
+type BackupServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BackupServiceClient) EXPECT() *BackupServiceClient_Expecter {
+	return &BackupServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *BackupServiceClient) Create(ctx context.Context, in *clickhouse.CreateBackupRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.CreateBackupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.CreateBackupRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.CreateBackupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BackupServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type BackupServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.CreateBackupRequest
+//   - opts ...grpc.CallOption
+func (_e *BackupServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *BackupServiceClient_Create_Call {
+	return &BackupServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *BackupServiceClient_Create_Call) Run(run func(ctx context.Context, in *clickhouse.CreateBackupRequest, opts ...grpc.CallOption)) *BackupServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.CreateBackupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *BackupServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *BackupServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BackupServiceClient_Create_Call) RunAndReturn(run func(context.Context, *clickhouse.CreateBackupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *BackupServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *BackupServiceClient) Delete(ctx context.Context, in *clickhouse.DeleteBackupRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.DeleteBackupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.DeleteBackupRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.DeleteBackupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BackupServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type BackupServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.DeleteBackupRequest
+//   - opts ...grpc.CallOption
+func (_e *BackupServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *BackupServiceClient_Delete_Call {
+	return &BackupServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *BackupServiceClient_Delete_Call) Run(run func(ctx context.Context, in *clickhouse.DeleteBackupRequest, opts ...grpc.CallOption)) *BackupServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.DeleteBackupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *BackupServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *BackupServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BackupServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *clickhouse.DeleteBackupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *BackupServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *BackupServiceClient) Get(ctx context.Context, in *clickhouse.GetBackupRequest, opts ...grpc.CallOption) (*clickhouse.Backup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *clickhouse.Backup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.GetBackupRequest, ...grpc.CallOption) (*clickhouse.Backup, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.GetBackupRequest, ...grpc.CallOption) *clickhouse.Backup); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.Backup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.GetBackupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BackupServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type BackupServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.GetBackupRequest
+//   - opts ...grpc.CallOption
+func (_e *BackupServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *BackupServiceClient_Get_Call {
+	return &BackupServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *BackupServiceClient_Get_Call) Run(run func(ctx context.Context, in *clickhouse.GetBackupRequest, opts ...grpc.CallOption)) *BackupServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.GetBackupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *BackupServiceClient_Get_Call) Return(_a0 *clickhouse.Backup, _a1 error) *BackupServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BackupServiceClient_Get_Call) RunAndReturn(run func(context.Context, *clickhouse.GetBackupRequest, ...grpc.CallOption) (*clickhouse.Backup, error)) *BackupServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *BackupServiceClient) List(ctx context.Context, in *clickhouse.ListBackupsRequest, opts ...grpc.CallOption) (*clickhouse.ListBackupsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *clickhouse.ListBackupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListBackupsRequest, ...grpc.CallOption) (*clickhouse.ListBackupsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListBackupsRequest, ...grpc.CallOption) *clickhouse.ListBackupsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.ListBackupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.ListBackupsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BackupServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type BackupServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.ListBackupsRequest
+//   - opts ...grpc.CallOption
+func (_e *BackupServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *BackupServiceClient_List_Call {
+	return &BackupServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *BackupServiceClient_List_Call) Run(run func(ctx context.Context, in *clickhouse.ListBackupsRequest, opts ...grpc.CallOption)) *BackupServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.ListBackupsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *BackupServiceClient_List_Call) Return(_a0 *clickhouse.ListBackupsResponse, _a1 error) *BackupServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BackupServiceClient_List_Call) RunAndReturn(run func(context.Context, *clickhouse.ListBackupsRequest, ...grpc.CallOption) (*clickhouse.ListBackupsResponse, error)) *BackupServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBackupServiceClient creates a new instance of BackupServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBackupServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BackupServiceClient {
+	mock := &BackupServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
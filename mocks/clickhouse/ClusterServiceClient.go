@@ -0,0 +1,1004 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package clickhouse
+
+import (
+	context "context"
+
+	clickhouse "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ClusterServiceClient is an autogenerated mock type for the ClusterServiceClient type
+type ClusterServiceClient struct {
+	mock.Mock
+}
+
+type ClusterServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClusterServiceClient) EXPECT() *ClusterServiceClient_Expecter {
+	return &ClusterServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) Create(ctx context.Context, in *clickhouse.CreateClusterRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.CreateClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.CreateClusterRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.CreateClusterRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ClusterServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.CreateClusterRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_Create_Call {
+	return &ClusterServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Create_Call) Run(run func(ctx context.Context, in *clickhouse.CreateClusterRequest, opts ...grpc.CallOption)) *ClusterServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.CreateClusterRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ClusterServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Create_Call) RunAndReturn(run func(context.Context, *clickhouse.CreateClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ClusterServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) Delete(ctx context.Context, in *clickhouse.DeleteClusterRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.DeleteClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.DeleteClusterRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.DeleteClusterRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ClusterServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.DeleteClusterRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_Delete_Call {
+	return &ClusterServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Delete_Call) Run(run func(ctx context.Context, in *clickhouse.DeleteClusterRequest, opts ...grpc.CallOption)) *ClusterServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.DeleteClusterRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ClusterServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *clickhouse.DeleteClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ClusterServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) Get(ctx context.Context, in *clickhouse.GetClusterRequest, opts ...grpc.CallOption) (*clickhouse.Cluster, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *clickhouse.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.GetClusterRequest, ...grpc.CallOption) (*clickhouse.Cluster, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.GetClusterRequest, ...grpc.CallOption) *clickhouse.Cluster); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.GetClusterRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ClusterServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.GetClusterRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_Get_Call {
+	return &ClusterServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Get_Call) Run(run func(ctx context.Context, in *clickhouse.GetClusterRequest, opts ...grpc.CallOption)) *ClusterServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.GetClusterRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Get_Call) Return(_a0 *clickhouse.Cluster, _a1 error) *ClusterServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Get_Call) RunAndReturn(run func(context.Context, *clickhouse.GetClusterRequest, ...grpc.CallOption) (*clickhouse.Cluster, error)) *ClusterServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) List(ctx context.Context, in *clickhouse.ListClustersRequest, opts ...grpc.CallOption) (*clickhouse.ListClustersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *clickhouse.ListClustersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListClustersRequest, ...grpc.CallOption) (*clickhouse.ListClustersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListClustersRequest, ...grpc.CallOption) *clickhouse.ListClustersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.ListClustersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.ListClustersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ClusterServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.ListClustersRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_List_Call {
+	return &ClusterServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_List_Call) Run(run func(ctx context.Context, in *clickhouse.ListClustersRequest, opts ...grpc.CallOption)) *ClusterServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.ListClustersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_List_Call) Return(_a0 *clickhouse.ListClustersResponse, _a1 error) *ClusterServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_List_Call) RunAndReturn(run func(context.Context, *clickhouse.ListClustersRequest, ...grpc.CallOption) (*clickhouse.ListClustersResponse, error)) *ClusterServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBackups provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) ListBackups(ctx context.Context, in *clickhouse.ListClusterBackupsRequest, opts ...grpc.CallOption) (*clickhouse.ListClusterBackupsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackups")
+	}
+
+	var r0 *clickhouse.ListClusterBackupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListClusterBackupsRequest, ...grpc.CallOption) (*clickhouse.ListClusterBackupsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListClusterBackupsRequest, ...grpc.CallOption) *clickhouse.ListClusterBackupsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.ListClusterBackupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.ListClusterBackupsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_ListBackups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackups'
+type ClusterServiceClient_ListBackups_Call struct {
+	*mock.Call
+}
+
+// ListBackups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.ListClusterBackupsRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) ListBackups(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_ListBackups_Call {
+	return &ClusterServiceClient_ListBackups_Call{Call: _e.mock.On("ListBackups",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_ListBackups_Call) Run(run func(ctx context.Context, in *clickhouse.ListClusterBackupsRequest, opts ...grpc.CallOption)) *ClusterServiceClient_ListBackups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.ListClusterBackupsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_ListBackups_Call) Return(_a0 *clickhouse.ListClusterBackupsResponse, _a1 error) *ClusterServiceClient_ListBackups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_ListBackups_Call) RunAndReturn(run func(context.Context, *clickhouse.ListClusterBackupsRequest, ...grpc.CallOption) (*clickhouse.ListClusterBackupsResponse, error)) *ClusterServiceClient_ListBackups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHosts provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) ListHosts(ctx context.Context, in *clickhouse.ListClusterHostsRequest, opts ...grpc.CallOption) (*clickhouse.ListClusterHostsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHosts")
+	}
+
+	var r0 *clickhouse.ListClusterHostsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListClusterHostsRequest, ...grpc.CallOption) (*clickhouse.ListClusterHostsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListClusterHostsRequest, ...grpc.CallOption) *clickhouse.ListClusterHostsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.ListClusterHostsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.ListClusterHostsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_ListHosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHosts'
+type ClusterServiceClient_ListHosts_Call struct {
+	*mock.Call
+}
+
+// ListHosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.ListClusterHostsRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) ListHosts(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_ListHosts_Call {
+	return &ClusterServiceClient_ListHosts_Call{Call: _e.mock.On("ListHosts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_ListHosts_Call) Run(run func(ctx context.Context, in *clickhouse.ListClusterHostsRequest, opts ...grpc.CallOption)) *ClusterServiceClient_ListHosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.ListClusterHostsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_ListHosts_Call) Return(_a0 *clickhouse.ListClusterHostsResponse, _a1 error) *ClusterServiceClient_ListHosts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_ListHosts_Call) RunAndReturn(run func(context.Context, *clickhouse.ListClusterHostsRequest, ...grpc.CallOption) (*clickhouse.ListClusterHostsResponse, error)) *ClusterServiceClient_ListHosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOperations provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) ListOperations(ctx context.Context, in *clickhouse.ListClusterOperationsRequest, opts ...grpc.CallOption) (*clickhouse.ListClusterOperationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOperations")
+	}
+
+	var r0 *clickhouse.ListClusterOperationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListClusterOperationsRequest, ...grpc.CallOption) (*clickhouse.ListClusterOperationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListClusterOperationsRequest, ...grpc.CallOption) *clickhouse.ListClusterOperationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.ListClusterOperationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.ListClusterOperationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_ListOperations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOperations'
+type ClusterServiceClient_ListOperations_Call struct {
+	*mock.Call
+}
+
+// ListOperations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.ListClusterOperationsRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) ListOperations(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_ListOperations_Call {
+	return &ClusterServiceClient_ListOperations_Call{Call: _e.mock.On("ListOperations",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_ListOperations_Call) Run(run func(ctx context.Context, in *clickhouse.ListClusterOperationsRequest, opts ...grpc.CallOption)) *ClusterServiceClient_ListOperations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.ListClusterOperationsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_ListOperations_Call) Return(_a0 *clickhouse.ListClusterOperationsResponse, _a1 error) *ClusterServiceClient_ListOperations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_ListOperations_Call) RunAndReturn(run func(context.Context, *clickhouse.ListClusterOperationsRequest, ...grpc.CallOption) (*clickhouse.ListClusterOperationsResponse, error)) *ClusterServiceClient_ListOperations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RescheduleMaintenance provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) RescheduleMaintenance(ctx context.Context, in *clickhouse.RescheduleMaintenanceRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RescheduleMaintenance")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.RescheduleMaintenanceRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.RescheduleMaintenanceRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.RescheduleMaintenanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_RescheduleMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RescheduleMaintenance'
+type ClusterServiceClient_RescheduleMaintenance_Call struct {
+	*mock.Call
+}
+
+// RescheduleMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.RescheduleMaintenanceRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) RescheduleMaintenance(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_RescheduleMaintenance_Call {
+	return &ClusterServiceClient_RescheduleMaintenance_Call{Call: _e.mock.On("RescheduleMaintenance",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_RescheduleMaintenance_Call) Run(run func(ctx context.Context, in *clickhouse.RescheduleMaintenanceRequest, opts ...grpc.CallOption)) *ClusterServiceClient_RescheduleMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.RescheduleMaintenanceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_RescheduleMaintenance_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ClusterServiceClient_RescheduleMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_RescheduleMaintenance_Call) RunAndReturn(run func(context.Context, *clickhouse.RescheduleMaintenanceRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ClusterServiceClient_RescheduleMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetCredentials provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) ResetCredentials(ctx context.Context, in *clickhouse.ResetClusterCredentialsRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetCredentials")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ResetClusterCredentialsRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ResetClusterCredentialsRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.ResetClusterCredentialsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_ResetCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetCredentials'
+type ClusterServiceClient_ResetCredentials_Call struct {
+	*mock.Call
+}
+
+// ResetCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.ResetClusterCredentialsRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) ResetCredentials(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_ResetCredentials_Call {
+	return &ClusterServiceClient_ResetCredentials_Call{Call: _e.mock.On("ResetCredentials",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_ResetCredentials_Call) Run(run func(ctx context.Context, in *clickhouse.ResetClusterCredentialsRequest, opts ...grpc.CallOption)) *ClusterServiceClient_ResetCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.ResetClusterCredentialsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_ResetCredentials_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ClusterServiceClient_ResetCredentials_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_ResetCredentials_Call) RunAndReturn(run func(context.Context, *clickhouse.ResetClusterCredentialsRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ClusterServiceClient_ResetCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) Restore(ctx context.Context, in *clickhouse.RestoreClusterRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.RestoreClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.RestoreClusterRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.RestoreClusterRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type ClusterServiceClient_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.RestoreClusterRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Restore(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_Restore_Call {
+	return &ClusterServiceClient_Restore_Call{Call: _e.mock.On("Restore",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Restore_Call) Run(run func(ctx context.Context, in *clickhouse.RestoreClusterRequest, opts ...grpc.CallOption)) *ClusterServiceClient_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.RestoreClusterRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Restore_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ClusterServiceClient_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Restore_Call) RunAndReturn(run func(context.Context, *clickhouse.RestoreClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ClusterServiceClient_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) Start(ctx context.Context, in *clickhouse.StartClusterRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.StartClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.StartClusterRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.StartClusterRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type ClusterServiceClient_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.StartClusterRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Start(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_Start_Call {
+	return &ClusterServiceClient_Start_Call{Call: _e.mock.On("Start",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Start_Call) Run(run func(ctx context.Context, in *clickhouse.StartClusterRequest, opts ...grpc.CallOption)) *ClusterServiceClient_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.StartClusterRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Start_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ClusterServiceClient_Start_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Start_Call) RunAndReturn(run func(context.Context, *clickhouse.StartClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ClusterServiceClient_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) Stop(ctx context.Context, in *clickhouse.StopClusterRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.StopClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.StopClusterRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.StopClusterRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type ClusterServiceClient_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.StopClusterRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Stop(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_Stop_Call {
+	return &ClusterServiceClient_Stop_Call{Call: _e.mock.On("Stop",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Stop_Call) Run(run func(ctx context.Context, in *clickhouse.StopClusterRequest, opts ...grpc.CallOption)) *ClusterServiceClient_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.StopClusterRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Stop_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ClusterServiceClient_Stop_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Stop_Call) RunAndReturn(run func(context.Context, *clickhouse.StopClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ClusterServiceClient_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *ClusterServiceClient) Update(ctx context.Context, in *clickhouse.UpdateClusterRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.UpdateClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.UpdateClusterRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.UpdateClusterRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ClusterServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.UpdateClusterRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *ClusterServiceClient_Update_Call {
+	return &ClusterServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Update_Call) Run(run func(ctx context.Context, in *clickhouse.UpdateClusterRequest, opts ...grpc.CallOption)) *ClusterServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.UpdateClusterRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ClusterServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Update_Call) RunAndReturn(run func(context.Context, *clickhouse.UpdateClusterRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ClusterServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClusterServiceClient creates a new instance of ClusterServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClusterServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClusterServiceClient {
+	mock := &ClusterServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,782 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package clickhouse
+
+import (
+	context "context"
+
+	clickhouse "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserServiceClient is an autogenerated mock type for the UserServiceClient type
+type UserServiceClient struct {
+	mock.Mock
+}
+
+type UserServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserServiceClient) EXPECT() *UserServiceClient_Expecter {
+	return &UserServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) Create(ctx context.Context, in *clickhouse.CreateUserRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.CreateUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.CreateUserRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.CreateUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type UserServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.CreateUserRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_Create_Call {
+	return &UserServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_Create_Call) Run(run func(ctx context.Context, in *clickhouse.CreateUserRequest, opts ...grpc.CallOption)) *UserServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.CreateUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_Create_Call) RunAndReturn(run func(context.Context, *clickhouse.CreateUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRole provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) CreateRole(ctx context.Context, in *clickhouse.CreateRoleRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.CreateRoleRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.CreateRoleRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.CreateRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type UserServiceClient_CreateRole_Call struct {
+	*mock.Call
+}
+
+// CreateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.CreateRoleRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) CreateRole(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_CreateRole_Call {
+	return &UserServiceClient_CreateRole_Call{Call: _e.mock.On("CreateRole",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_CreateRole_Call) Run(run func(ctx context.Context, in *clickhouse.CreateRoleRequest, opts ...grpc.CallOption)) *UserServiceClient_CreateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.CreateRoleRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_CreateRole_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_CreateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_CreateRole_Call) RunAndReturn(run func(context.Context, *clickhouse.CreateRoleRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_CreateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) Delete(ctx context.Context, in *clickhouse.DeleteUserRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.DeleteUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.DeleteUserRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.DeleteUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type UserServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.DeleteUserRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_Delete_Call {
+	return &UserServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_Delete_Call) Run(run func(ctx context.Context, in *clickhouse.DeleteUserRequest, opts ...grpc.CallOption)) *UserServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.DeleteUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *clickhouse.DeleteUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRole provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) DeleteRole(ctx context.Context, in *clickhouse.DeleteRoleRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.DeleteRoleRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.DeleteRoleRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.DeleteRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type UserServiceClient_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.DeleteRoleRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) DeleteRole(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_DeleteRole_Call {
+	return &UserServiceClient_DeleteRole_Call{Call: _e.mock.On("DeleteRole",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_DeleteRole_Call) Run(run func(ctx context.Context, in *clickhouse.DeleteRoleRequest, opts ...grpc.CallOption)) *UserServiceClient_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.DeleteRoleRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_DeleteRole_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_DeleteRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_DeleteRole_Call) RunAndReturn(run func(context.Context, *clickhouse.DeleteRoleRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) Get(ctx context.Context, in *clickhouse.GetUserRequest, opts ...grpc.CallOption) (*clickhouse.User, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *clickhouse.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.GetUserRequest, ...grpc.CallOption) (*clickhouse.User, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.GetUserRequest, ...grpc.CallOption) *clickhouse.User); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.GetUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type UserServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.GetUserRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_Get_Call {
+	return &UserServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_Get_Call) Run(run func(ctx context.Context, in *clickhouse.GetUserRequest, opts ...grpc.CallOption)) *UserServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.GetUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_Get_Call) Return(_a0 *clickhouse.User, _a1 error) *UserServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_Get_Call) RunAndReturn(run func(context.Context, *clickhouse.GetUserRequest, ...grpc.CallOption) (*clickhouse.User, error)) *UserServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRole provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) GetRole(ctx context.Context, in *clickhouse.GetRoleRequest, opts ...grpc.CallOption) (*clickhouse.Role, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRole")
+	}
+
+	var r0 *clickhouse.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.GetRoleRequest, ...grpc.CallOption) (*clickhouse.Role, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.GetRoleRequest, ...grpc.CallOption) *clickhouse.Role); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.GetRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_GetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRole'
+type UserServiceClient_GetRole_Call struct {
+	*mock.Call
+}
+
+// GetRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.GetRoleRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) GetRole(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_GetRole_Call {
+	return &UserServiceClient_GetRole_Call{Call: _e.mock.On("GetRole",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_GetRole_Call) Run(run func(ctx context.Context, in *clickhouse.GetRoleRequest, opts ...grpc.CallOption)) *UserServiceClient_GetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.GetRoleRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_GetRole_Call) Return(_a0 *clickhouse.Role, _a1 error) *UserServiceClient_GetRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_GetRole_Call) RunAndReturn(run func(context.Context, *clickhouse.GetRoleRequest, ...grpc.CallOption) (*clickhouse.Role, error)) *UserServiceClient_GetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) List(ctx context.Context, in *clickhouse.ListUsersRequest, opts ...grpc.CallOption) (*clickhouse.ListUsersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *clickhouse.ListUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListUsersRequest, ...grpc.CallOption) (*clickhouse.ListUsersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListUsersRequest, ...grpc.CallOption) *clickhouse.ListUsersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.ListUsersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.ListUsersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type UserServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.ListUsersRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_List_Call {
+	return &UserServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_List_Call) Run(run func(ctx context.Context, in *clickhouse.ListUsersRequest, opts ...grpc.CallOption)) *UserServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.ListUsersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_List_Call) Return(_a0 *clickhouse.ListUsersResponse, _a1 error) *UserServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_List_Call) RunAndReturn(run func(context.Context, *clickhouse.ListUsersRequest, ...grpc.CallOption) (*clickhouse.ListUsersResponse, error)) *UserServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoles provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) ListRoles(ctx context.Context, in *clickhouse.ListRolesRequest, opts ...grpc.CallOption) (*clickhouse.ListRolesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 *clickhouse.ListRolesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListRolesRequest, ...grpc.CallOption) (*clickhouse.ListRolesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.ListRolesRequest, ...grpc.CallOption) *clickhouse.ListRolesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clickhouse.ListRolesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.ListRolesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
+type UserServiceClient_ListRoles_Call struct {
+	*mock.Call
+}
+
+// ListRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.ListRolesRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) ListRoles(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_ListRoles_Call {
+	return &UserServiceClient_ListRoles_Call{Call: _e.mock.On("ListRoles",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_ListRoles_Call) Run(run func(ctx context.Context, in *clickhouse.ListRolesRequest, opts ...grpc.CallOption)) *UserServiceClient_ListRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.ListRolesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_ListRoles_Call) Return(_a0 *clickhouse.ListRolesResponse, _a1 error) *UserServiceClient_ListRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_ListRoles_Call) RunAndReturn(run func(context.Context, *clickhouse.ListRolesRequest, ...grpc.CallOption) (*clickhouse.ListRolesResponse, error)) *UserServiceClient_ListRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) Update(ctx context.Context, in *clickhouse.UpdateUserRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.UpdateUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.UpdateUserRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.UpdateUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UserServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.UpdateUserRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_Update_Call {
+	return &UserServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_Update_Call) Run(run func(ctx context.Context, in *clickhouse.UpdateUserRequest, opts ...grpc.CallOption)) *UserServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.UpdateUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_Update_Call) RunAndReturn(run func(context.Context, *clickhouse.UpdateUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) UpdateRole(ctx context.Context, in *clickhouse.UpdateRoleRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.UpdateRoleRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clickhouse.UpdateRoleRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clickhouse.UpdateRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type UserServiceClient_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clickhouse.UpdateRoleRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) UpdateRole(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_UpdateRole_Call {
+	return &UserServiceClient_UpdateRole_Call{Call: _e.mock.On("UpdateRole",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_UpdateRole_Call) Run(run func(ctx context.Context, in *clickhouse.UpdateRoleRequest, opts ...grpc.CallOption)) *UserServiceClient_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clickhouse.UpdateRoleRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_UpdateRole_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_UpdateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_UpdateRole_Call) RunAndReturn(run func(context.Context, *clickhouse.UpdateRoleRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserServiceClient creates a new instance of UserServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserServiceClient {
+	mock := &UserServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,559 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package kafka
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	kafka "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConnectorServiceClient is an autogenerated mock type for the ConnectorServiceClient type
+type ConnectorServiceClient struct {
+	mock.Mock
+}
+
+type ConnectorServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConnectorServiceClient) EXPECT() *ConnectorServiceClient_Expecter {
+	return &ConnectorServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *ConnectorServiceClient) Create(ctx context.Context, in *kafka.CreateConnectorRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.CreateConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.CreateConnectorRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.CreateConnectorRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConnectorServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ConnectorServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.CreateConnectorRequest
+//   - opts ...grpc.CallOption
+func (_e *ConnectorServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *ConnectorServiceClient_Create_Call {
+	return &ConnectorServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ConnectorServiceClient_Create_Call) Run(run func(ctx context.Context, in *kafka.CreateConnectorRequest, opts ...grpc.CallOption)) *ConnectorServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.CreateConnectorRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ConnectorServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Create_Call) RunAndReturn(run func(context.Context, *kafka.CreateConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ConnectorServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *ConnectorServiceClient) Delete(ctx context.Context, in *kafka.DeleteConnectorRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.DeleteConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.DeleteConnectorRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.DeleteConnectorRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConnectorServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ConnectorServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.DeleteConnectorRequest
+//   - opts ...grpc.CallOption
+func (_e *ConnectorServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *ConnectorServiceClient_Delete_Call {
+	return &ConnectorServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ConnectorServiceClient_Delete_Call) Run(run func(ctx context.Context, in *kafka.DeleteConnectorRequest, opts ...grpc.CallOption)) *ConnectorServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.DeleteConnectorRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ConnectorServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *kafka.DeleteConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ConnectorServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *ConnectorServiceClient) Get(ctx context.Context, in *kafka.GetConnectorRequest, opts ...grpc.CallOption) (*kafka.Connector, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *kafka.Connector
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.GetConnectorRequest, ...grpc.CallOption) (*kafka.Connector, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.GetConnectorRequest, ...grpc.CallOption) *kafka.Connector); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kafka.Connector)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.GetConnectorRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConnectorServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ConnectorServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.GetConnectorRequest
+//   - opts ...grpc.CallOption
+func (_e *ConnectorServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *ConnectorServiceClient_Get_Call {
+	return &ConnectorServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ConnectorServiceClient_Get_Call) Run(run func(ctx context.Context, in *kafka.GetConnectorRequest, opts ...grpc.CallOption)) *ConnectorServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.GetConnectorRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Get_Call) Return(_a0 *kafka.Connector, _a1 error) *ConnectorServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Get_Call) RunAndReturn(run func(context.Context, *kafka.GetConnectorRequest, ...grpc.CallOption) (*kafka.Connector, error)) *ConnectorServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *ConnectorServiceClient) List(ctx context.Context, in *kafka.ListConnectorsRequest, opts ...grpc.CallOption) (*kafka.ListConnectorsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *kafka.ListConnectorsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ListConnectorsRequest, ...grpc.CallOption) (*kafka.ListConnectorsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ListConnectorsRequest, ...grpc.CallOption) *kafka.ListConnectorsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kafka.ListConnectorsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.ListConnectorsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConnectorServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ConnectorServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.ListConnectorsRequest
+//   - opts ...grpc.CallOption
+func (_e *ConnectorServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *ConnectorServiceClient_List_Call {
+	return &ConnectorServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ConnectorServiceClient_List_Call) Run(run func(ctx context.Context, in *kafka.ListConnectorsRequest, opts ...grpc.CallOption)) *ConnectorServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.ListConnectorsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ConnectorServiceClient_List_Call) Return(_a0 *kafka.ListConnectorsResponse, _a1 error) *ConnectorServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConnectorServiceClient_List_Call) RunAndReturn(run func(context.Context, *kafka.ListConnectorsRequest, ...grpc.CallOption) (*kafka.ListConnectorsResponse, error)) *ConnectorServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pause provides a mock function with given fields: ctx, in, opts
+func (_m *ConnectorServiceClient) Pause(ctx context.Context, in *kafka.PauseConnectorRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pause")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.PauseConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.PauseConnectorRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.PauseConnectorRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConnectorServiceClient_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type ConnectorServiceClient_Pause_Call struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.PauseConnectorRequest
+//   - opts ...grpc.CallOption
+func (_e *ConnectorServiceClient_Expecter) Pause(ctx interface{}, in interface{}, opts ...interface{}) *ConnectorServiceClient_Pause_Call {
+	return &ConnectorServiceClient_Pause_Call{Call: _e.mock.On("Pause",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ConnectorServiceClient_Pause_Call) Run(run func(ctx context.Context, in *kafka.PauseConnectorRequest, opts ...grpc.CallOption)) *ConnectorServiceClient_Pause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.PauseConnectorRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Pause_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ConnectorServiceClient_Pause_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Pause_Call) RunAndReturn(run func(context.Context, *kafka.PauseConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ConnectorServiceClient_Pause_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with given fields: ctx, in, opts
+func (_m *ConnectorServiceClient) Resume(ctx context.Context, in *kafka.ResumeConnectorRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ResumeConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ResumeConnectorRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.ResumeConnectorRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConnectorServiceClient_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type ConnectorServiceClient_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.ResumeConnectorRequest
+//   - opts ...grpc.CallOption
+func (_e *ConnectorServiceClient_Expecter) Resume(ctx interface{}, in interface{}, opts ...interface{}) *ConnectorServiceClient_Resume_Call {
+	return &ConnectorServiceClient_Resume_Call{Call: _e.mock.On("Resume",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ConnectorServiceClient_Resume_Call) Run(run func(ctx context.Context, in *kafka.ResumeConnectorRequest, opts ...grpc.CallOption)) *ConnectorServiceClient_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.ResumeConnectorRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Resume_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ConnectorServiceClient_Resume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Resume_Call) RunAndReturn(run func(context.Context, *kafka.ResumeConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ConnectorServiceClient_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *ConnectorServiceClient) Update(ctx context.Context, in *kafka.UpdateConnectorRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.UpdateConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.UpdateConnectorRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.UpdateConnectorRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConnectorServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ConnectorServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.UpdateConnectorRequest
+//   - opts ...grpc.CallOption
+func (_e *ConnectorServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *ConnectorServiceClient_Update_Call {
+	return &ConnectorServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ConnectorServiceClient_Update_Call) Run(run func(ctx context.Context, in *kafka.UpdateConnectorRequest, opts ...grpc.CallOption)) *ConnectorServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.UpdateConnectorRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *ConnectorServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConnectorServiceClient_Update_Call) RunAndReturn(run func(context.Context, *kafka.UpdateConnectorRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *ConnectorServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConnectorServiceClient creates a new instance of ConnectorServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConnectorServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConnectorServiceClient {
+	mock := &ConnectorServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
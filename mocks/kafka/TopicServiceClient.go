@@ -0,0 +1,411 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package kafka
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	kafka "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TopicServiceClient is an autogenerated mock type for the TopicServiceClient type
+type TopicServiceClient struct {
+	mock.Mock
+}
+
+type TopicServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TopicServiceClient) EXPECT() *TopicServiceClient_Expecter {
+	return &TopicServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *TopicServiceClient) Create(ctx context.Context, in *kafka.CreateTopicRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.CreateTopicRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.CreateTopicRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.CreateTopicRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopicServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TopicServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.CreateTopicRequest
+//   - opts ...grpc.CallOption
+func (_e *TopicServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *TopicServiceClient_Create_Call {
+	return &TopicServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TopicServiceClient_Create_Call) Run(run func(ctx context.Context, in *kafka.CreateTopicRequest, opts ...grpc.CallOption)) *TopicServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.CreateTopicRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TopicServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TopicServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TopicServiceClient_Create_Call) RunAndReturn(run func(context.Context, *kafka.CreateTopicRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TopicServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *TopicServiceClient) Delete(ctx context.Context, in *kafka.DeleteTopicRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.DeleteTopicRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.DeleteTopicRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.DeleteTopicRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopicServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TopicServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.DeleteTopicRequest
+//   - opts ...grpc.CallOption
+func (_e *TopicServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *TopicServiceClient_Delete_Call {
+	return &TopicServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TopicServiceClient_Delete_Call) Run(run func(ctx context.Context, in *kafka.DeleteTopicRequest, opts ...grpc.CallOption)) *TopicServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.DeleteTopicRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TopicServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TopicServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TopicServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *kafka.DeleteTopicRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TopicServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *TopicServiceClient) Get(ctx context.Context, in *kafka.GetTopicRequest, opts ...grpc.CallOption) (*kafka.Topic, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *kafka.Topic
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.GetTopicRequest, ...grpc.CallOption) (*kafka.Topic, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.GetTopicRequest, ...grpc.CallOption) *kafka.Topic); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kafka.Topic)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.GetTopicRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopicServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TopicServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.GetTopicRequest
+//   - opts ...grpc.CallOption
+func (_e *TopicServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *TopicServiceClient_Get_Call {
+	return &TopicServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TopicServiceClient_Get_Call) Run(run func(ctx context.Context, in *kafka.GetTopicRequest, opts ...grpc.CallOption)) *TopicServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.GetTopicRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TopicServiceClient_Get_Call) Return(_a0 *kafka.Topic, _a1 error) *TopicServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TopicServiceClient_Get_Call) RunAndReturn(run func(context.Context, *kafka.GetTopicRequest, ...grpc.CallOption) (*kafka.Topic, error)) *TopicServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *TopicServiceClient) List(ctx context.Context, in *kafka.ListTopicsRequest, opts ...grpc.CallOption) (*kafka.ListTopicsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *kafka.ListTopicsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ListTopicsRequest, ...grpc.CallOption) (*kafka.ListTopicsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ListTopicsRequest, ...grpc.CallOption) *kafka.ListTopicsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kafka.ListTopicsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.ListTopicsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopicServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type TopicServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.ListTopicsRequest
+//   - opts ...grpc.CallOption
+func (_e *TopicServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *TopicServiceClient_List_Call {
+	return &TopicServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TopicServiceClient_List_Call) Run(run func(ctx context.Context, in *kafka.ListTopicsRequest, opts ...grpc.CallOption)) *TopicServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.ListTopicsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TopicServiceClient_List_Call) Return(_a0 *kafka.ListTopicsResponse, _a1 error) *TopicServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TopicServiceClient_List_Call) RunAndReturn(run func(context.Context, *kafka.ListTopicsRequest, ...grpc.CallOption) (*kafka.ListTopicsResponse, error)) *TopicServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *TopicServiceClient) Update(ctx context.Context, in *kafka.UpdateTopicRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.UpdateTopicRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.UpdateTopicRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.UpdateTopicRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopicServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TopicServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.UpdateTopicRequest
+//   - opts ...grpc.CallOption
+func (_e *TopicServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *TopicServiceClient_Update_Call {
+	return &TopicServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TopicServiceClient_Update_Call) Run(run func(ctx context.Context, in *kafka.UpdateTopicRequest, opts ...grpc.CallOption)) *TopicServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.UpdateTopicRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TopicServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TopicServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TopicServiceClient_Update_Call) RunAndReturn(run func(context.Context, *kafka.UpdateTopicRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TopicServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTopicServiceClient creates a new instance of TopicServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTopicServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TopicServiceClient {
+	mock := &TopicServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
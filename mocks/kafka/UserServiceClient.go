@@ -0,0 +1,559 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package kafka
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	kafka "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserServiceClient is an autogenerated mock type for the UserServiceClient type
+type UserServiceClient struct {
+	mock.Mock
+}
+
+type UserServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserServiceClient) EXPECT() *UserServiceClient_Expecter {
+	return &UserServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) Create(ctx context.Context, in *kafka.CreateUserRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.CreateUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.CreateUserRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.CreateUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type UserServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.CreateUserRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_Create_Call {
+	return &UserServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_Create_Call) Run(run func(ctx context.Context, in *kafka.CreateUserRequest, opts ...grpc.CallOption)) *UserServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.CreateUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_Create_Call) RunAndReturn(run func(context.Context, *kafka.CreateUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) Delete(ctx context.Context, in *kafka.DeleteUserRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.DeleteUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.DeleteUserRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.DeleteUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type UserServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.DeleteUserRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_Delete_Call {
+	return &UserServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_Delete_Call) Run(run func(ctx context.Context, in *kafka.DeleteUserRequest, opts ...grpc.CallOption)) *UserServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.DeleteUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *kafka.DeleteUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) Get(ctx context.Context, in *kafka.GetUserRequest, opts ...grpc.CallOption) (*kafka.User, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *kafka.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.GetUserRequest, ...grpc.CallOption) (*kafka.User, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.GetUserRequest, ...grpc.CallOption) *kafka.User); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kafka.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.GetUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type UserServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.GetUserRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_Get_Call {
+	return &UserServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_Get_Call) Run(run func(ctx context.Context, in *kafka.GetUserRequest, opts ...grpc.CallOption)) *UserServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.GetUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_Get_Call) Return(_a0 *kafka.User, _a1 error) *UserServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_Get_Call) RunAndReturn(run func(context.Context, *kafka.GetUserRequest, ...grpc.CallOption) (*kafka.User, error)) *UserServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GrantPermission provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) GrantPermission(ctx context.Context, in *kafka.GrantUserPermissionRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantPermission")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.GrantUserPermissionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.GrantUserPermissionRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.GrantUserPermissionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_GrantPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantPermission'
+type UserServiceClient_GrantPermission_Call struct {
+	*mock.Call
+}
+
+// GrantPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.GrantUserPermissionRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) GrantPermission(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_GrantPermission_Call {
+	return &UserServiceClient_GrantPermission_Call{Call: _e.mock.On("GrantPermission",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_GrantPermission_Call) Run(run func(ctx context.Context, in *kafka.GrantUserPermissionRequest, opts ...grpc.CallOption)) *UserServiceClient_GrantPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.GrantUserPermissionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_GrantPermission_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_GrantPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_GrantPermission_Call) RunAndReturn(run func(context.Context, *kafka.GrantUserPermissionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_GrantPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) List(ctx context.Context, in *kafka.ListUsersRequest, opts ...grpc.CallOption) (*kafka.ListUsersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *kafka.ListUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ListUsersRequest, ...grpc.CallOption) (*kafka.ListUsersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ListUsersRequest, ...grpc.CallOption) *kafka.ListUsersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kafka.ListUsersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.ListUsersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type UserServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.ListUsersRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_List_Call {
+	return &UserServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_List_Call) Run(run func(ctx context.Context, in *kafka.ListUsersRequest, opts ...grpc.CallOption)) *UserServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.ListUsersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_List_Call) Return(_a0 *kafka.ListUsersResponse, _a1 error) *UserServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_List_Call) RunAndReturn(run func(context.Context, *kafka.ListUsersRequest, ...grpc.CallOption) (*kafka.ListUsersResponse, error)) *UserServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokePermission provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) RevokePermission(ctx context.Context, in *kafka.RevokeUserPermissionRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokePermission")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.RevokeUserPermissionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.RevokeUserPermissionRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.RevokeUserPermissionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_RevokePermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePermission'
+type UserServiceClient_RevokePermission_Call struct {
+	*mock.Call
+}
+
+// RevokePermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.RevokeUserPermissionRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) RevokePermission(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_RevokePermission_Call {
+	return &UserServiceClient_RevokePermission_Call{Call: _e.mock.On("RevokePermission",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_RevokePermission_Call) Run(run func(ctx context.Context, in *kafka.RevokeUserPermissionRequest, opts ...grpc.CallOption)) *UserServiceClient_RevokePermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.RevokeUserPermissionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_RevokePermission_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_RevokePermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_RevokePermission_Call) RunAndReturn(run func(context.Context, *kafka.RevokeUserPermissionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_RevokePermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *UserServiceClient) Update(ctx context.Context, in *kafka.UpdateUserRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.UpdateUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.UpdateUserRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.UpdateUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UserServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.UpdateUserRequest
+//   - opts ...grpc.CallOption
+func (_e *UserServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *UserServiceClient_Update_Call {
+	return &UserServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UserServiceClient_Update_Call) Run(run func(ctx context.Context, in *kafka.UpdateUserRequest, opts ...grpc.CallOption)) *UserServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.UpdateUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *UserServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceClient_Update_Call) RunAndReturn(run func(context.Context, *kafka.UpdateUserRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *UserServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserServiceClient creates a new instance of UserServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserServiceClient {
+	mock := &UserServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,114 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package kafka
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	kafka "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// VersionServiceClient is an autogenerated mock type for the VersionServiceClient type
+type VersionServiceClient struct {
+	mock.Mock
+}
+
+type VersionServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VersionServiceClient) EXPECT() *VersionServiceClient_Expecter {
+	return &VersionServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *VersionServiceClient) List(ctx context.Context, in *kafka.ListVersionsRequest, opts ...grpc.CallOption) (*kafka.ListVersionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *kafka.ListVersionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ListVersionsRequest, ...grpc.CallOption) (*kafka.ListVersionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *kafka.ListVersionsRequest, ...grpc.CallOption) *kafka.ListVersionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kafka.ListVersionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *kafka.ListVersionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VersionServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type VersionServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *kafka.ListVersionsRequest
+//   - opts ...grpc.CallOption
+func (_e *VersionServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *VersionServiceClient_List_Call {
+	return &VersionServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *VersionServiceClient_List_Call) Run(run func(ctx context.Context, in *kafka.ListVersionsRequest, opts ...grpc.CallOption)) *VersionServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*kafka.ListVersionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *VersionServiceClient_List_Call) Return(_a0 *kafka.ListVersionsResponse, _a1 error) *VersionServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VersionServiceClient_List_Call) RunAndReturn(run func(context.Context, *kafka.ListVersionsRequest, ...grpc.CallOption) (*kafka.ListVersionsResponse, error)) *VersionServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVersionServiceClient creates a new instance of VersionServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVersionServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VersionServiceClient {
+	mock := &VersionServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
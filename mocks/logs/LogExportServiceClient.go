@@ -0,0 +1,411 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package logs
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	logs "github.com/doublecloud/go-genproto/doublecloud/logs/v1"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LogExportServiceClient is an autogenerated mock type for the LogExportServiceClient type
+type LogExportServiceClient struct {
+	mock.Mock
+}
+
+type LogExportServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LogExportServiceClient) EXPECT() *LogExportServiceClient_Expecter {
+	return &LogExportServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *LogExportServiceClient) Create(ctx context.Context, in *logs.CreateExportRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.CreateExportRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.CreateExportRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logs.CreateExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogExportServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type LogExportServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *logs.CreateExportRequest
+//   - opts ...grpc.CallOption
+func (_e *LogExportServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *LogExportServiceClient_Create_Call {
+	return &LogExportServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *LogExportServiceClient_Create_Call) Run(run func(ctx context.Context, in *logs.CreateExportRequest, opts ...grpc.CallOption)) *LogExportServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*logs.CreateExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *LogExportServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *LogExportServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogExportServiceClient_Create_Call) RunAndReturn(run func(context.Context, *logs.CreateExportRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *LogExportServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *LogExportServiceClient) Delete(ctx context.Context, in *logs.DeleteExportRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.DeleteExportRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.DeleteExportRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logs.DeleteExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogExportServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type LogExportServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *logs.DeleteExportRequest
+//   - opts ...grpc.CallOption
+func (_e *LogExportServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *LogExportServiceClient_Delete_Call {
+	return &LogExportServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *LogExportServiceClient_Delete_Call) Run(run func(ctx context.Context, in *logs.DeleteExportRequest, opts ...grpc.CallOption)) *LogExportServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*logs.DeleteExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *LogExportServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *LogExportServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogExportServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *logs.DeleteExportRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *LogExportServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *LogExportServiceClient) Get(ctx context.Context, in *logs.GetExportRequest, opts ...grpc.CallOption) (*logs.LogsExport, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *logs.LogsExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.GetExportRequest, ...grpc.CallOption) (*logs.LogsExport, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.GetExportRequest, ...grpc.CallOption) *logs.LogsExport); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logs.LogsExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logs.GetExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogExportServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type LogExportServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *logs.GetExportRequest
+//   - opts ...grpc.CallOption
+func (_e *LogExportServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *LogExportServiceClient_Get_Call {
+	return &LogExportServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *LogExportServiceClient_Get_Call) Run(run func(ctx context.Context, in *logs.GetExportRequest, opts ...grpc.CallOption)) *LogExportServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*logs.GetExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *LogExportServiceClient_Get_Call) Return(_a0 *logs.LogsExport, _a1 error) *LogExportServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogExportServiceClient_Get_Call) RunAndReturn(run func(context.Context, *logs.GetExportRequest, ...grpc.CallOption) (*logs.LogsExport, error)) *LogExportServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *LogExportServiceClient) List(ctx context.Context, in *logs.ListExportRequest, opts ...grpc.CallOption) (*logs.ListExportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *logs.ListExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.ListExportRequest, ...grpc.CallOption) (*logs.ListExportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.ListExportRequest, ...grpc.CallOption) *logs.ListExportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logs.ListExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logs.ListExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogExportServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type LogExportServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *logs.ListExportRequest
+//   - opts ...grpc.CallOption
+func (_e *LogExportServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *LogExportServiceClient_List_Call {
+	return &LogExportServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *LogExportServiceClient_List_Call) Run(run func(ctx context.Context, in *logs.ListExportRequest, opts ...grpc.CallOption)) *LogExportServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*logs.ListExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *LogExportServiceClient_List_Call) Return(_a0 *logs.ListExportResponse, _a1 error) *LogExportServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogExportServiceClient_List_Call) RunAndReturn(run func(context.Context, *logs.ListExportRequest, ...grpc.CallOption) (*logs.ListExportResponse, error)) *LogExportServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *LogExportServiceClient) Update(ctx context.Context, in *logs.UpdateExportRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.UpdateExportRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.UpdateExportRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logs.UpdateExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogExportServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type LogExportServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *logs.UpdateExportRequest
+//   - opts ...grpc.CallOption
+func (_e *LogExportServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *LogExportServiceClient_Update_Call {
+	return &LogExportServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *LogExportServiceClient_Update_Call) Run(run func(ctx context.Context, in *logs.UpdateExportRequest, opts ...grpc.CallOption)) *LogExportServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*logs.UpdateExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *LogExportServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *LogExportServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogExportServiceClient_Update_Call) RunAndReturn(run func(context.Context, *logs.UpdateExportRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *LogExportServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLogExportServiceClient creates a new instance of LogExportServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLogExportServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LogExportServiceClient {
+	mock := &LogExportServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,114 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package logs
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	logs "github.com/doublecloud/go-genproto/doublecloud/logs/v1"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LogServiceClient is an autogenerated mock type for the LogServiceClient type
+type LogServiceClient struct {
+	mock.Mock
+}
+
+type LogServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LogServiceClient) EXPECT() *LogServiceClient_Expecter {
+	return &LogServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Read provides a mock function with given fields: ctx, in, opts
+func (_m *LogServiceClient) Read(ctx context.Context, in *logs.ReadLogsRequest, opts ...grpc.CallOption) (logs.LogService_ReadClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 logs.LogService_ReadClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.ReadLogsRequest, ...grpc.CallOption) (logs.LogService_ReadClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.ReadLogsRequest, ...grpc.CallOption) logs.LogService_ReadClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logs.LogService_ReadClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logs.ReadLogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogServiceClient_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type LogServiceClient_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *logs.ReadLogsRequest
+//   - opts ...grpc.CallOption
+func (_e *LogServiceClient_Expecter) Read(ctx interface{}, in interface{}, opts ...interface{}) *LogServiceClient_Read_Call {
+	return &LogServiceClient_Read_Call{Call: _e.mock.On("Read",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *LogServiceClient_Read_Call) Run(run func(ctx context.Context, in *logs.ReadLogsRequest, opts ...grpc.CallOption)) *LogServiceClient_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*logs.ReadLogsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *LogServiceClient_Read_Call) Return(_a0 logs.LogService_ReadClient, _a1 error) *LogServiceClient_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogServiceClient_Read_Call) RunAndReturn(run func(context.Context, *logs.ReadLogsRequest, ...grpc.CallOption) (logs.LogService_ReadClient, error)) *LogServiceClient_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLogServiceClient creates a new instance of LogServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLogServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LogServiceClient {
+	mock := &LogServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
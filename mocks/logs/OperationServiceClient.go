@@ -0,0 +1,115 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package logs
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	logs "github.com/doublecloud/go-genproto/doublecloud/logs/v1"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OperationServiceClient is an autogenerated mock type for the OperationServiceClient type
+type OperationServiceClient struct {
+	mock.Mock
+}
+
+type OperationServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OperationServiceClient) EXPECT() *OperationServiceClient_Expecter {
+	return &OperationServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *OperationServiceClient) Get(ctx context.Context, in *logs.GetOperationRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.GetOperationRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logs.GetOperationRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logs.GetOperationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OperationServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type OperationServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *logs.GetOperationRequest
+//   - opts ...grpc.CallOption
+func (_e *OperationServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *OperationServiceClient_Get_Call {
+	return &OperationServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *OperationServiceClient_Get_Call) Run(run func(ctx context.Context, in *logs.GetOperationRequest, opts ...grpc.CallOption)) *OperationServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*logs.GetOperationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OperationServiceClient_Get_Call) Return(_a0 *doublecloud.Operation, _a1 error) *OperationServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OperationServiceClient_Get_Call) RunAndReturn(run func(context.Context, *logs.GetOperationRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *OperationServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOperationServiceClient creates a new instance of OperationServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOperationServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OperationServiceClient {
+	mock := &OperationServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,337 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package network
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	network "github.com/doublecloud/go-genproto/doublecloud/network/v1"
+)
+
+// NetworkConnectionServiceClient is an autogenerated mock type for the NetworkConnectionServiceClient type
+type NetworkConnectionServiceClient struct {
+	mock.Mock
+}
+
+type NetworkConnectionServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NetworkConnectionServiceClient) EXPECT() *NetworkConnectionServiceClient_Expecter {
+	return &NetworkConnectionServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkConnectionServiceClient) Create(ctx context.Context, in *network.CreateNetworkConnectionRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.CreateNetworkConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.CreateNetworkConnectionRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.CreateNetworkConnectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkConnectionServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type NetworkConnectionServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.CreateNetworkConnectionRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkConnectionServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *NetworkConnectionServiceClient_Create_Call {
+	return &NetworkConnectionServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkConnectionServiceClient_Create_Call) Run(run func(ctx context.Context, in *network.CreateNetworkConnectionRequest, opts ...grpc.CallOption)) *NetworkConnectionServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.CreateNetworkConnectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkConnectionServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *NetworkConnectionServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkConnectionServiceClient_Create_Call) RunAndReturn(run func(context.Context, *network.CreateNetworkConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *NetworkConnectionServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkConnectionServiceClient) Delete(ctx context.Context, in *network.DeleteNetworkConnectionRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.DeleteNetworkConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.DeleteNetworkConnectionRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.DeleteNetworkConnectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkConnectionServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type NetworkConnectionServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.DeleteNetworkConnectionRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkConnectionServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *NetworkConnectionServiceClient_Delete_Call {
+	return &NetworkConnectionServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkConnectionServiceClient_Delete_Call) Run(run func(ctx context.Context, in *network.DeleteNetworkConnectionRequest, opts ...grpc.CallOption)) *NetworkConnectionServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.DeleteNetworkConnectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkConnectionServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *NetworkConnectionServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkConnectionServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *network.DeleteNetworkConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *NetworkConnectionServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkConnectionServiceClient) Get(ctx context.Context, in *network.GetNetworkConnectionRequest, opts ...grpc.CallOption) (*network.NetworkConnection, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *network.NetworkConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.GetNetworkConnectionRequest, ...grpc.CallOption) (*network.NetworkConnection, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.GetNetworkConnectionRequest, ...grpc.CallOption) *network.NetworkConnection); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*network.NetworkConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.GetNetworkConnectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkConnectionServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type NetworkConnectionServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.GetNetworkConnectionRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkConnectionServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *NetworkConnectionServiceClient_Get_Call {
+	return &NetworkConnectionServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkConnectionServiceClient_Get_Call) Run(run func(ctx context.Context, in *network.GetNetworkConnectionRequest, opts ...grpc.CallOption)) *NetworkConnectionServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.GetNetworkConnectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkConnectionServiceClient_Get_Call) Return(_a0 *network.NetworkConnection, _a1 error) *NetworkConnectionServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkConnectionServiceClient_Get_Call) RunAndReturn(run func(context.Context, *network.GetNetworkConnectionRequest, ...grpc.CallOption) (*network.NetworkConnection, error)) *NetworkConnectionServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkConnectionServiceClient) List(ctx context.Context, in *network.ListNetworkConnectionsRequest, opts ...grpc.CallOption) (*network.ListNetworkConnectionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *network.ListNetworkConnectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.ListNetworkConnectionsRequest, ...grpc.CallOption) (*network.ListNetworkConnectionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.ListNetworkConnectionsRequest, ...grpc.CallOption) *network.ListNetworkConnectionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*network.ListNetworkConnectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.ListNetworkConnectionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkConnectionServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type NetworkConnectionServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.ListNetworkConnectionsRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkConnectionServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *NetworkConnectionServiceClient_List_Call {
+	return &NetworkConnectionServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkConnectionServiceClient_List_Call) Run(run func(ctx context.Context, in *network.ListNetworkConnectionsRequest, opts ...grpc.CallOption)) *NetworkConnectionServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.ListNetworkConnectionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkConnectionServiceClient_List_Call) Return(_a0 *network.ListNetworkConnectionsResponse, _a1 error) *NetworkConnectionServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkConnectionServiceClient_List_Call) RunAndReturn(run func(context.Context, *network.ListNetworkConnectionsRequest, ...grpc.CallOption) (*network.ListNetworkConnectionsResponse, error)) *NetworkConnectionServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNetworkConnectionServiceClient creates a new instance of NetworkConnectionServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNetworkConnectionServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NetworkConnectionServiceClient {
+	mock := &NetworkConnectionServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
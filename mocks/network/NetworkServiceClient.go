@@ -0,0 +1,411 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package network
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	network "github.com/doublecloud/go-genproto/doublecloud/network/v1"
+)
+
+// NetworkServiceClient is an autogenerated mock type for the NetworkServiceClient type
+type NetworkServiceClient struct {
+	mock.Mock
+}
+
+type NetworkServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NetworkServiceClient) EXPECT() *NetworkServiceClient_Expecter {
+	return &NetworkServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkServiceClient) Create(ctx context.Context, in *network.CreateNetworkRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.CreateNetworkRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.CreateNetworkRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.CreateNetworkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type NetworkServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.CreateNetworkRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *NetworkServiceClient_Create_Call {
+	return &NetworkServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkServiceClient_Create_Call) Run(run func(ctx context.Context, in *network.CreateNetworkRequest, opts ...grpc.CallOption)) *NetworkServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.CreateNetworkRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *NetworkServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkServiceClient_Create_Call) RunAndReturn(run func(context.Context, *network.CreateNetworkRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *NetworkServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkServiceClient) Delete(ctx context.Context, in *network.DeleteNetworkRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.DeleteNetworkRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.DeleteNetworkRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.DeleteNetworkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type NetworkServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.DeleteNetworkRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *NetworkServiceClient_Delete_Call {
+	return &NetworkServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkServiceClient_Delete_Call) Run(run func(ctx context.Context, in *network.DeleteNetworkRequest, opts ...grpc.CallOption)) *NetworkServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.DeleteNetworkRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *NetworkServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *network.DeleteNetworkRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *NetworkServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkServiceClient) Get(ctx context.Context, in *network.GetNetworkRequest, opts ...grpc.CallOption) (*network.Network, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *network.Network
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.GetNetworkRequest, ...grpc.CallOption) (*network.Network, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.GetNetworkRequest, ...grpc.CallOption) *network.Network); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*network.Network)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.GetNetworkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type NetworkServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.GetNetworkRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *NetworkServiceClient_Get_Call {
+	return &NetworkServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkServiceClient_Get_Call) Run(run func(ctx context.Context, in *network.GetNetworkRequest, opts ...grpc.CallOption)) *NetworkServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.GetNetworkRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkServiceClient_Get_Call) Return(_a0 *network.Network, _a1 error) *NetworkServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkServiceClient_Get_Call) RunAndReturn(run func(context.Context, *network.GetNetworkRequest, ...grpc.CallOption) (*network.Network, error)) *NetworkServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkServiceClient) Import(ctx context.Context, in *network.ImportNetworkRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.ImportNetworkRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.ImportNetworkRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.ImportNetworkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkServiceClient_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type NetworkServiceClient_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.ImportNetworkRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkServiceClient_Expecter) Import(ctx interface{}, in interface{}, opts ...interface{}) *NetworkServiceClient_Import_Call {
+	return &NetworkServiceClient_Import_Call{Call: _e.mock.On("Import",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkServiceClient_Import_Call) Run(run func(ctx context.Context, in *network.ImportNetworkRequest, opts ...grpc.CallOption)) *NetworkServiceClient_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.ImportNetworkRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkServiceClient_Import_Call) Return(_a0 *doublecloud.Operation, _a1 error) *NetworkServiceClient_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkServiceClient_Import_Call) RunAndReturn(run func(context.Context, *network.ImportNetworkRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *NetworkServiceClient_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *NetworkServiceClient) List(ctx context.Context, in *network.ListNetworksRequest, opts ...grpc.CallOption) (*network.ListNetworksResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *network.ListNetworksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *network.ListNetworksRequest, ...grpc.CallOption) (*network.ListNetworksResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *network.ListNetworksRequest, ...grpc.CallOption) *network.ListNetworksResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*network.ListNetworksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *network.ListNetworksRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type NetworkServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *network.ListNetworksRequest
+//   - opts ...grpc.CallOption
+func (_e *NetworkServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *NetworkServiceClient_List_Call {
+	return &NetworkServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *NetworkServiceClient_List_Call) Run(run func(ctx context.Context, in *network.ListNetworksRequest, opts ...grpc.CallOption)) *NetworkServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*network.ListNetworksRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NetworkServiceClient_List_Call) Return(_a0 *network.ListNetworksResponse, _a1 error) *NetworkServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkServiceClient_List_Call) RunAndReturn(run func(context.Context, *network.ListNetworksRequest, ...grpc.CallOption) (*network.ListNetworksResponse, error)) *NetworkServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNetworkServiceClient creates a new instance of NetworkServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNetworkServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NetworkServiceClient {
+	mock := &NetworkServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,485 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package organization
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	organizationmanager "github.com/doublecloud/go-genproto/doublecloud/organizationmanager/v1"
+)
+
+// GroupMappingServiceClient is an autogenerated mock type for the GroupMappingServiceClient type
+type GroupMappingServiceClient struct {
+	mock.Mock
+}
+
+type GroupMappingServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupMappingServiceClient) EXPECT() *GroupMappingServiceClient_Expecter {
+	return &GroupMappingServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *GroupMappingServiceClient) Create(ctx context.Context, in *organizationmanager.CreateGroupMappingRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.CreateGroupMappingRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.CreateGroupMappingRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.CreateGroupMappingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMappingServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type GroupMappingServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.CreateGroupMappingRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupMappingServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *GroupMappingServiceClient_Create_Call {
+	return &GroupMappingServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupMappingServiceClient_Create_Call) Run(run func(ctx context.Context, in *organizationmanager.CreateGroupMappingRequest, opts ...grpc.CallOption)) *GroupMappingServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.CreateGroupMappingRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupMappingServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_Create_Call) RunAndReturn(run func(context.Context, *organizationmanager.CreateGroupMappingRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupMappingServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *GroupMappingServiceClient) Delete(ctx context.Context, in *organizationmanager.DeleteGroupMappingRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.DeleteGroupMappingRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.DeleteGroupMappingRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.DeleteGroupMappingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMappingServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type GroupMappingServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.DeleteGroupMappingRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupMappingServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *GroupMappingServiceClient_Delete_Call {
+	return &GroupMappingServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupMappingServiceClient_Delete_Call) Run(run func(ctx context.Context, in *organizationmanager.DeleteGroupMappingRequest, opts ...grpc.CallOption)) *GroupMappingServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.DeleteGroupMappingRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupMappingServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *organizationmanager.DeleteGroupMappingRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupMappingServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *GroupMappingServiceClient) Get(ctx context.Context, in *organizationmanager.GetGroupMappingRequest, opts ...grpc.CallOption) (*organizationmanager.GetGroupMappingResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *organizationmanager.GetGroupMappingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.GetGroupMappingRequest, ...grpc.CallOption) (*organizationmanager.GetGroupMappingResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.GetGroupMappingRequest, ...grpc.CallOption) *organizationmanager.GetGroupMappingResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organizationmanager.GetGroupMappingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.GetGroupMappingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMappingServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type GroupMappingServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.GetGroupMappingRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupMappingServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *GroupMappingServiceClient_Get_Call {
+	return &GroupMappingServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupMappingServiceClient_Get_Call) Run(run func(ctx context.Context, in *organizationmanager.GetGroupMappingRequest, opts ...grpc.CallOption)) *GroupMappingServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.GetGroupMappingRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_Get_Call) Return(_a0 *organizationmanager.GetGroupMappingResponse, _a1 error) *GroupMappingServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_Get_Call) RunAndReturn(run func(context.Context, *organizationmanager.GetGroupMappingRequest, ...grpc.CallOption) (*organizationmanager.GetGroupMappingResponse, error)) *GroupMappingServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListItems provides a mock function with given fields: ctx, in, opts
+func (_m *GroupMappingServiceClient) ListItems(ctx context.Context, in *organizationmanager.ListGroupMappingItemsRequest, opts ...grpc.CallOption) (*organizationmanager.ListGroupMappingItemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListItems")
+	}
+
+	var r0 *organizationmanager.ListGroupMappingItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.ListGroupMappingItemsRequest, ...grpc.CallOption) (*organizationmanager.ListGroupMappingItemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.ListGroupMappingItemsRequest, ...grpc.CallOption) *organizationmanager.ListGroupMappingItemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organizationmanager.ListGroupMappingItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.ListGroupMappingItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMappingServiceClient_ListItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListItems'
+type GroupMappingServiceClient_ListItems_Call struct {
+	*mock.Call
+}
+
+// ListItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.ListGroupMappingItemsRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupMappingServiceClient_Expecter) ListItems(ctx interface{}, in interface{}, opts ...interface{}) *GroupMappingServiceClient_ListItems_Call {
+	return &GroupMappingServiceClient_ListItems_Call{Call: _e.mock.On("ListItems",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupMappingServiceClient_ListItems_Call) Run(run func(ctx context.Context, in *organizationmanager.ListGroupMappingItemsRequest, opts ...grpc.CallOption)) *GroupMappingServiceClient_ListItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.ListGroupMappingItemsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_ListItems_Call) Return(_a0 *organizationmanager.ListGroupMappingItemsResponse, _a1 error) *GroupMappingServiceClient_ListItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_ListItems_Call) RunAndReturn(run func(context.Context, *organizationmanager.ListGroupMappingItemsRequest, ...grpc.CallOption) (*organizationmanager.ListGroupMappingItemsResponse, error)) *GroupMappingServiceClient_ListItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *GroupMappingServiceClient) Update(ctx context.Context, in *organizationmanager.UpdateGroupMappingRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.UpdateGroupMappingRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.UpdateGroupMappingRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.UpdateGroupMappingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMappingServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type GroupMappingServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.UpdateGroupMappingRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupMappingServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *GroupMappingServiceClient_Update_Call {
+	return &GroupMappingServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupMappingServiceClient_Update_Call) Run(run func(ctx context.Context, in *organizationmanager.UpdateGroupMappingRequest, opts ...grpc.CallOption)) *GroupMappingServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.UpdateGroupMappingRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupMappingServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_Update_Call) RunAndReturn(run func(context.Context, *organizationmanager.UpdateGroupMappingRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupMappingServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateItems provides a mock function with given fields: ctx, in, opts
+func (_m *GroupMappingServiceClient) UpdateItems(ctx context.Context, in *organizationmanager.UpdateGroupMappingItemsRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateItems")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.UpdateGroupMappingItemsRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.UpdateGroupMappingItemsRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.UpdateGroupMappingItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupMappingServiceClient_UpdateItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateItems'
+type GroupMappingServiceClient_UpdateItems_Call struct {
+	*mock.Call
+}
+
+// UpdateItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.UpdateGroupMappingItemsRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupMappingServiceClient_Expecter) UpdateItems(ctx interface{}, in interface{}, opts ...interface{}) *GroupMappingServiceClient_UpdateItems_Call {
+	return &GroupMappingServiceClient_UpdateItems_Call{Call: _e.mock.On("UpdateItems",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupMappingServiceClient_UpdateItems_Call) Run(run func(ctx context.Context, in *organizationmanager.UpdateGroupMappingItemsRequest, opts ...grpc.CallOption)) *GroupMappingServiceClient_UpdateItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.UpdateGroupMappingItemsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_UpdateItems_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupMappingServiceClient_UpdateItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupMappingServiceClient_UpdateItems_Call) RunAndReturn(run func(context.Context, *organizationmanager.UpdateGroupMappingItemsRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupMappingServiceClient_UpdateItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGroupMappingServiceClient creates a new instance of GroupMappingServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGroupMappingServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GroupMappingServiceClient {
+	mock := &GroupMappingServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
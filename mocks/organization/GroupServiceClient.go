@@ -0,0 +1,858 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package organization
+
+import (
+	context "context"
+
+	access "github.com/doublecloud/go-genproto/doublecloud/access/v1"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	organizationmanager "github.com/doublecloud/go-genproto/doublecloud/organizationmanager/v1"
+)
+
+// GroupServiceClient is an autogenerated mock type for the GroupServiceClient type
+type GroupServiceClient struct {
+	mock.Mock
+}
+
+type GroupServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupServiceClient) EXPECT() *GroupServiceClient_Expecter {
+	return &GroupServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) Create(ctx context.Context, in *organizationmanager.CreateGroupRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.CreateGroupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.CreateGroupRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.CreateGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type GroupServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.CreateGroupRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_Create_Call {
+	return &GroupServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_Create_Call) Run(run func(ctx context.Context, in *organizationmanager.CreateGroupRequest, opts ...grpc.CallOption)) *GroupServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.CreateGroupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_Create_Call) RunAndReturn(run func(context.Context, *organizationmanager.CreateGroupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) Delete(ctx context.Context, in *organizationmanager.DeleteGroupRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.DeleteGroupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.DeleteGroupRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.DeleteGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type GroupServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.DeleteGroupRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_Delete_Call {
+	return &GroupServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_Delete_Call) Run(run func(ctx context.Context, in *organizationmanager.DeleteGroupRequest, opts ...grpc.CallOption)) *GroupServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.DeleteGroupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *organizationmanager.DeleteGroupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) Get(ctx context.Context, in *organizationmanager.GetGroupRequest, opts ...grpc.CallOption) (*organizationmanager.Group, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *organizationmanager.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.GetGroupRequest, ...grpc.CallOption) (*organizationmanager.Group, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.GetGroupRequest, ...grpc.CallOption) *organizationmanager.Group); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organizationmanager.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.GetGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type GroupServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.GetGroupRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_Get_Call {
+	return &GroupServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_Get_Call) Run(run func(ctx context.Context, in *organizationmanager.GetGroupRequest, opts ...grpc.CallOption)) *GroupServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.GetGroupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_Get_Call) Return(_a0 *organizationmanager.Group, _a1 error) *GroupServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_Get_Call) RunAndReturn(run func(context.Context, *organizationmanager.GetGroupRequest, ...grpc.CallOption) (*organizationmanager.Group, error)) *GroupServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) List(ctx context.Context, in *organizationmanager.ListGroupsRequest, opts ...grpc.CallOption) (*organizationmanager.ListGroupsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *organizationmanager.ListGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.ListGroupsRequest, ...grpc.CallOption) (*organizationmanager.ListGroupsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.ListGroupsRequest, ...grpc.CallOption) *organizationmanager.ListGroupsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organizationmanager.ListGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.ListGroupsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type GroupServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.ListGroupsRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_List_Call {
+	return &GroupServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_List_Call) Run(run func(ctx context.Context, in *organizationmanager.ListGroupsRequest, opts ...grpc.CallOption)) *GroupServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.ListGroupsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_List_Call) Return(_a0 *organizationmanager.ListGroupsResponse, _a1 error) *GroupServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_List_Call) RunAndReturn(run func(context.Context, *organizationmanager.ListGroupsRequest, ...grpc.CallOption) (*organizationmanager.ListGroupsResponse, error)) *GroupServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccessBindings provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) ListAccessBindings(ctx context.Context, in *access.ListAccessBindingsRequest, opts ...grpc.CallOption) (*access.ListAccessBindingsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAccessBindings")
+	}
+
+	var r0 *access.ListAccessBindingsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *access.ListAccessBindingsRequest, ...grpc.CallOption) (*access.ListAccessBindingsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *access.ListAccessBindingsRequest, ...grpc.CallOption) *access.ListAccessBindingsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*access.ListAccessBindingsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *access.ListAccessBindingsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_ListAccessBindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccessBindings'
+type GroupServiceClient_ListAccessBindings_Call struct {
+	*mock.Call
+}
+
+// ListAccessBindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *access.ListAccessBindingsRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) ListAccessBindings(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_ListAccessBindings_Call {
+	return &GroupServiceClient_ListAccessBindings_Call{Call: _e.mock.On("ListAccessBindings",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_ListAccessBindings_Call) Run(run func(ctx context.Context, in *access.ListAccessBindingsRequest, opts ...grpc.CallOption)) *GroupServiceClient_ListAccessBindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*access.ListAccessBindingsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_ListAccessBindings_Call) Return(_a0 *access.ListAccessBindingsResponse, _a1 error) *GroupServiceClient_ListAccessBindings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_ListAccessBindings_Call) RunAndReturn(run func(context.Context, *access.ListAccessBindingsRequest, ...grpc.CallOption) (*access.ListAccessBindingsResponse, error)) *GroupServiceClient_ListAccessBindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) ListMembers(ctx context.Context, in *organizationmanager.ListGroupMembersRequest, opts ...grpc.CallOption) (*organizationmanager.ListGroupMembersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 *organizationmanager.ListGroupMembersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.ListGroupMembersRequest, ...grpc.CallOption) (*organizationmanager.ListGroupMembersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.ListGroupMembersRequest, ...grpc.CallOption) *organizationmanager.ListGroupMembersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organizationmanager.ListGroupMembersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.ListGroupMembersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type GroupServiceClient_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.ListGroupMembersRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) ListMembers(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_ListMembers_Call {
+	return &GroupServiceClient_ListMembers_Call{Call: _e.mock.On("ListMembers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_ListMembers_Call) Run(run func(ctx context.Context, in *organizationmanager.ListGroupMembersRequest, opts ...grpc.CallOption)) *GroupServiceClient_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.ListGroupMembersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_ListMembers_Call) Return(_a0 *organizationmanager.ListGroupMembersResponse, _a1 error) *GroupServiceClient_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_ListMembers_Call) RunAndReturn(run func(context.Context, *organizationmanager.ListGroupMembersRequest, ...grpc.CallOption) (*organizationmanager.ListGroupMembersResponse, error)) *GroupServiceClient_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOperations provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) ListOperations(ctx context.Context, in *organizationmanager.ListGroupOperationsRequest, opts ...grpc.CallOption) (*organizationmanager.ListGroupOperationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOperations")
+	}
+
+	var r0 *organizationmanager.ListGroupOperationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.ListGroupOperationsRequest, ...grpc.CallOption) (*organizationmanager.ListGroupOperationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.ListGroupOperationsRequest, ...grpc.CallOption) *organizationmanager.ListGroupOperationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organizationmanager.ListGroupOperationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.ListGroupOperationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_ListOperations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOperations'
+type GroupServiceClient_ListOperations_Call struct {
+	*mock.Call
+}
+
+// ListOperations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.ListGroupOperationsRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) ListOperations(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_ListOperations_Call {
+	return &GroupServiceClient_ListOperations_Call{Call: _e.mock.On("ListOperations",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_ListOperations_Call) Run(run func(ctx context.Context, in *organizationmanager.ListGroupOperationsRequest, opts ...grpc.CallOption)) *GroupServiceClient_ListOperations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.ListGroupOperationsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_ListOperations_Call) Return(_a0 *organizationmanager.ListGroupOperationsResponse, _a1 error) *GroupServiceClient_ListOperations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_ListOperations_Call) RunAndReturn(run func(context.Context, *organizationmanager.ListGroupOperationsRequest, ...grpc.CallOption) (*organizationmanager.ListGroupOperationsResponse, error)) *GroupServiceClient_ListOperations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAccessBindings provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) SetAccessBindings(ctx context.Context, in *access.SetAccessBindingsRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAccessBindings")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *access.SetAccessBindingsRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *access.SetAccessBindingsRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *access.SetAccessBindingsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_SetAccessBindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAccessBindings'
+type GroupServiceClient_SetAccessBindings_Call struct {
+	*mock.Call
+}
+
+// SetAccessBindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *access.SetAccessBindingsRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) SetAccessBindings(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_SetAccessBindings_Call {
+	return &GroupServiceClient_SetAccessBindings_Call{Call: _e.mock.On("SetAccessBindings",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_SetAccessBindings_Call) Run(run func(ctx context.Context, in *access.SetAccessBindingsRequest, opts ...grpc.CallOption)) *GroupServiceClient_SetAccessBindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*access.SetAccessBindingsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_SetAccessBindings_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupServiceClient_SetAccessBindings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_SetAccessBindings_Call) RunAndReturn(run func(context.Context, *access.SetAccessBindingsRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupServiceClient_SetAccessBindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) Update(ctx context.Context, in *organizationmanager.UpdateGroupRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.UpdateGroupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.UpdateGroupRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.UpdateGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type GroupServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.UpdateGroupRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_Update_Call {
+	return &GroupServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_Update_Call) Run(run func(ctx context.Context, in *organizationmanager.UpdateGroupRequest, opts ...grpc.CallOption)) *GroupServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.UpdateGroupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_Update_Call) RunAndReturn(run func(context.Context, *organizationmanager.UpdateGroupRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccessBindings provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) UpdateAccessBindings(ctx context.Context, in *access.UpdateAccessBindingsRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccessBindings")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *access.UpdateAccessBindingsRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *access.UpdateAccessBindingsRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *access.UpdateAccessBindingsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_UpdateAccessBindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccessBindings'
+type GroupServiceClient_UpdateAccessBindings_Call struct {
+	*mock.Call
+}
+
+// UpdateAccessBindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *access.UpdateAccessBindingsRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) UpdateAccessBindings(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_UpdateAccessBindings_Call {
+	return &GroupServiceClient_UpdateAccessBindings_Call{Call: _e.mock.On("UpdateAccessBindings",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_UpdateAccessBindings_Call) Run(run func(ctx context.Context, in *access.UpdateAccessBindingsRequest, opts ...grpc.CallOption)) *GroupServiceClient_UpdateAccessBindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*access.UpdateAccessBindingsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_UpdateAccessBindings_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupServiceClient_UpdateAccessBindings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_UpdateAccessBindings_Call) RunAndReturn(run func(context.Context, *access.UpdateAccessBindingsRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupServiceClient_UpdateAccessBindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMembers provides a mock function with given fields: ctx, in, opts
+func (_m *GroupServiceClient) UpdateMembers(ctx context.Context, in *organizationmanager.UpdateGroupMembersRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMembers")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.UpdateGroupMembersRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *organizationmanager.UpdateGroupMembersRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *organizationmanager.UpdateGroupMembersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupServiceClient_UpdateMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMembers'
+type GroupServiceClient_UpdateMembers_Call struct {
+	*mock.Call
+}
+
+// UpdateMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *organizationmanager.UpdateGroupMembersRequest
+//   - opts ...grpc.CallOption
+func (_e *GroupServiceClient_Expecter) UpdateMembers(ctx interface{}, in interface{}, opts ...interface{}) *GroupServiceClient_UpdateMembers_Call {
+	return &GroupServiceClient_UpdateMembers_Call{Call: _e.mock.On("UpdateMembers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupServiceClient_UpdateMembers_Call) Run(run func(ctx context.Context, in *organizationmanager.UpdateGroupMembersRequest, opts ...grpc.CallOption)) *GroupServiceClient_UpdateMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*organizationmanager.UpdateGroupMembersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupServiceClient_UpdateMembers_Call) Return(_a0 *doublecloud.Operation, _a1 error) *GroupServiceClient_UpdateMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupServiceClient_UpdateMembers_Call) RunAndReturn(run func(context.Context, *organizationmanager.UpdateGroupMembersRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *GroupServiceClient_UpdateMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGroupServiceClient creates a new instance of GroupServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGroupServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GroupServiceClient {
+	mock := &GroupServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,337 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package saml
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	saml "github.com/doublecloud/go-genproto/doublecloud/organizationmanager/saml/v1"
+)
+
+// FederationServiceClient is an autogenerated mock type for the FederationServiceClient type
+type FederationServiceClient struct {
+	mock.Mock
+}
+
+type FederationServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FederationServiceClient) EXPECT() *FederationServiceClient_Expecter {
+	return &FederationServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *FederationServiceClient) Create(ctx context.Context, in *saml.CreateFederationRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *saml.CreateFederationRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *saml.CreateFederationRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *saml.CreateFederationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FederationServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type FederationServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *saml.CreateFederationRequest
+//   - opts ...grpc.CallOption
+func (_e *FederationServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *FederationServiceClient_Create_Call {
+	return &FederationServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FederationServiceClient_Create_Call) Run(run func(ctx context.Context, in *saml.CreateFederationRequest, opts ...grpc.CallOption)) *FederationServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*saml.CreateFederationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FederationServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *FederationServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FederationServiceClient_Create_Call) RunAndReturn(run func(context.Context, *saml.CreateFederationRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *FederationServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *FederationServiceClient) Delete(ctx context.Context, in *saml.DeleteFederationRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *saml.DeleteFederationRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *saml.DeleteFederationRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *saml.DeleteFederationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FederationServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type FederationServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *saml.DeleteFederationRequest
+//   - opts ...grpc.CallOption
+func (_e *FederationServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *FederationServiceClient_Delete_Call {
+	return &FederationServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FederationServiceClient_Delete_Call) Run(run func(ctx context.Context, in *saml.DeleteFederationRequest, opts ...grpc.CallOption)) *FederationServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*saml.DeleteFederationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FederationServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *FederationServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FederationServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *saml.DeleteFederationRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *FederationServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *FederationServiceClient) Get(ctx context.Context, in *saml.GetFederationRequest, opts ...grpc.CallOption) (*saml.Federation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *saml.Federation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *saml.GetFederationRequest, ...grpc.CallOption) (*saml.Federation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *saml.GetFederationRequest, ...grpc.CallOption) *saml.Federation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*saml.Federation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *saml.GetFederationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FederationServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type FederationServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *saml.GetFederationRequest
+//   - opts ...grpc.CallOption
+func (_e *FederationServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *FederationServiceClient_Get_Call {
+	return &FederationServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FederationServiceClient_Get_Call) Run(run func(ctx context.Context, in *saml.GetFederationRequest, opts ...grpc.CallOption)) *FederationServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*saml.GetFederationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FederationServiceClient_Get_Call) Return(_a0 *saml.Federation, _a1 error) *FederationServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FederationServiceClient_Get_Call) RunAndReturn(run func(context.Context, *saml.GetFederationRequest, ...grpc.CallOption) (*saml.Federation, error)) *FederationServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *FederationServiceClient) Update(ctx context.Context, in *saml.UpdateFederationRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *saml.UpdateFederationRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *saml.UpdateFederationRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *saml.UpdateFederationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FederationServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type FederationServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *saml.UpdateFederationRequest
+//   - opts ...grpc.CallOption
+func (_e *FederationServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *FederationServiceClient_Update_Call {
+	return &FederationServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FederationServiceClient_Update_Call) Run(run func(ctx context.Context, in *saml.UpdateFederationRequest, opts ...grpc.CallOption)) *FederationServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*saml.UpdateFederationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FederationServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *FederationServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FederationServiceClient_Update_Call) RunAndReturn(run func(context.Context, *saml.UpdateFederationRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *FederationServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFederationServiceClient creates a new instance of FederationServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFederationServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FederationServiceClient {
+	mock := &FederationServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,411 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package transfer
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	transfer "github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+)
+
+// EndpointServiceClient is an autogenerated mock type for the EndpointServiceClient type
+type EndpointServiceClient struct {
+	mock.Mock
+}
+
+type EndpointServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EndpointServiceClient) EXPECT() *EndpointServiceClient_Expecter {
+	return &EndpointServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *EndpointServiceClient) Create(ctx context.Context, in *transfer.CreateEndpointRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.CreateEndpointRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.CreateEndpointRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.CreateEndpointRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EndpointServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type EndpointServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.CreateEndpointRequest
+//   - opts ...grpc.CallOption
+func (_e *EndpointServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *EndpointServiceClient_Create_Call {
+	return &EndpointServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EndpointServiceClient_Create_Call) Run(run func(ctx context.Context, in *transfer.CreateEndpointRequest, opts ...grpc.CallOption)) *EndpointServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.CreateEndpointRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EndpointServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *EndpointServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EndpointServiceClient_Create_Call) RunAndReturn(run func(context.Context, *transfer.CreateEndpointRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *EndpointServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *EndpointServiceClient) Delete(ctx context.Context, in *transfer.DeleteEndpointRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.DeleteEndpointRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.DeleteEndpointRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.DeleteEndpointRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EndpointServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type EndpointServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.DeleteEndpointRequest
+//   - opts ...grpc.CallOption
+func (_e *EndpointServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *EndpointServiceClient_Delete_Call {
+	return &EndpointServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EndpointServiceClient_Delete_Call) Run(run func(ctx context.Context, in *transfer.DeleteEndpointRequest, opts ...grpc.CallOption)) *EndpointServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.DeleteEndpointRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EndpointServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *EndpointServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EndpointServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *transfer.DeleteEndpointRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *EndpointServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *EndpointServiceClient) Get(ctx context.Context, in *transfer.GetEndpointRequest, opts ...grpc.CallOption) (*transfer.Endpoint, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *transfer.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.GetEndpointRequest, ...grpc.CallOption) (*transfer.Endpoint, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.GetEndpointRequest, ...grpc.CallOption) *transfer.Endpoint); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transfer.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.GetEndpointRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EndpointServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type EndpointServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.GetEndpointRequest
+//   - opts ...grpc.CallOption
+func (_e *EndpointServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *EndpointServiceClient_Get_Call {
+	return &EndpointServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EndpointServiceClient_Get_Call) Run(run func(ctx context.Context, in *transfer.GetEndpointRequest, opts ...grpc.CallOption)) *EndpointServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.GetEndpointRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EndpointServiceClient_Get_Call) Return(_a0 *transfer.Endpoint, _a1 error) *EndpointServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EndpointServiceClient_Get_Call) RunAndReturn(run func(context.Context, *transfer.GetEndpointRequest, ...grpc.CallOption) (*transfer.Endpoint, error)) *EndpointServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *EndpointServiceClient) List(ctx context.Context, in *transfer.ListEndpointsRequest, opts ...grpc.CallOption) (*transfer.ListEndpointsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *transfer.ListEndpointsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.ListEndpointsRequest, ...grpc.CallOption) (*transfer.ListEndpointsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.ListEndpointsRequest, ...grpc.CallOption) *transfer.ListEndpointsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transfer.ListEndpointsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.ListEndpointsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EndpointServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type EndpointServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.ListEndpointsRequest
+//   - opts ...grpc.CallOption
+func (_e *EndpointServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *EndpointServiceClient_List_Call {
+	return &EndpointServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EndpointServiceClient_List_Call) Run(run func(ctx context.Context, in *transfer.ListEndpointsRequest, opts ...grpc.CallOption)) *EndpointServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.ListEndpointsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EndpointServiceClient_List_Call) Return(_a0 *transfer.ListEndpointsResponse, _a1 error) *EndpointServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EndpointServiceClient_List_Call) RunAndReturn(run func(context.Context, *transfer.ListEndpointsRequest, ...grpc.CallOption) (*transfer.ListEndpointsResponse, error)) *EndpointServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *EndpointServiceClient) Update(ctx context.Context, in *transfer.UpdateEndpointRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.UpdateEndpointRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.UpdateEndpointRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.UpdateEndpointRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EndpointServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type EndpointServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.UpdateEndpointRequest
+//   - opts ...grpc.CallOption
+func (_e *EndpointServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *EndpointServiceClient_Update_Call {
+	return &EndpointServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EndpointServiceClient_Update_Call) Run(run func(ctx context.Context, in *transfer.UpdateEndpointRequest, opts ...grpc.CallOption)) *EndpointServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.UpdateEndpointRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EndpointServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *EndpointServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EndpointServiceClient_Update_Call) RunAndReturn(run func(context.Context, *transfer.UpdateEndpointRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *EndpointServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEndpointServiceClient creates a new instance of EndpointServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEndpointServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EndpointServiceClient {
+	mock := &EndpointServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
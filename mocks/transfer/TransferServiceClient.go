@@ -0,0 +1,781 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package transfer
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	transfer "github.com/doublecloud/go-genproto/doublecloud/transfer/v1"
+)
+
+// TransferServiceClient is an autogenerated mock type for the TransferServiceClient type
+type TransferServiceClient struct {
+	mock.Mock
+}
+
+type TransferServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransferServiceClient) EXPECT() *TransferServiceClient_Expecter {
+	return &TransferServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Activate provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) Activate(ctx context.Context, in *transfer.ActivateTransferRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Activate")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.ActivateTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.ActivateTransferRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.ActivateTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_Activate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Activate'
+type TransferServiceClient_Activate_Call struct {
+	*mock.Call
+}
+
+// Activate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.ActivateTransferRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) Activate(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_Activate_Call {
+	return &TransferServiceClient_Activate_Call{Call: _e.mock.On("Activate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_Activate_Call) Run(run func(ctx context.Context, in *transfer.ActivateTransferRequest, opts ...grpc.CallOption)) *TransferServiceClient_Activate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.ActivateTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_Activate_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TransferServiceClient_Activate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_Activate_Call) RunAndReturn(run func(context.Context, *transfer.ActivateTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TransferServiceClient_Activate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) Create(ctx context.Context, in *transfer.CreateTransferRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.CreateTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.CreateTransferRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.CreateTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TransferServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.CreateTransferRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_Create_Call {
+	return &TransferServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_Create_Call) Run(run func(ctx context.Context, in *transfer.CreateTransferRequest, opts ...grpc.CallOption)) *TransferServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.CreateTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TransferServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_Create_Call) RunAndReturn(run func(context.Context, *transfer.CreateTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TransferServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deactivate provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) Deactivate(ctx context.Context, in *transfer.DeactivateTransferRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deactivate")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.DeactivateTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.DeactivateTransferRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.DeactivateTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_Deactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deactivate'
+type TransferServiceClient_Deactivate_Call struct {
+	*mock.Call
+}
+
+// Deactivate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.DeactivateTransferRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) Deactivate(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_Deactivate_Call {
+	return &TransferServiceClient_Deactivate_Call{Call: _e.mock.On("Deactivate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_Deactivate_Call) Run(run func(ctx context.Context, in *transfer.DeactivateTransferRequest, opts ...grpc.CallOption)) *TransferServiceClient_Deactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.DeactivateTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_Deactivate_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TransferServiceClient_Deactivate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_Deactivate_Call) RunAndReturn(run func(context.Context, *transfer.DeactivateTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TransferServiceClient_Deactivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) Delete(ctx context.Context, in *transfer.DeleteTransferRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.DeleteTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.DeleteTransferRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.DeleteTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TransferServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.DeleteTransferRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_Delete_Call {
+	return &TransferServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_Delete_Call) Run(run func(ctx context.Context, in *transfer.DeleteTransferRequest, opts ...grpc.CallOption)) *TransferServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.DeleteTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TransferServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *transfer.DeleteTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TransferServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMetricExporterConnectionInfo provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) DeleteMetricExporterConnectionInfo(ctx context.Context, in *transfer.DeleteExporterConnectionInfoRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMetricExporterConnectionInfo")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.DeleteExporterConnectionInfoRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.DeleteExporterConnectionInfoRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.DeleteExporterConnectionInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_DeleteMetricExporterConnectionInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMetricExporterConnectionInfo'
+type TransferServiceClient_DeleteMetricExporterConnectionInfo_Call struct {
+	*mock.Call
+}
+
+// DeleteMetricExporterConnectionInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.DeleteExporterConnectionInfoRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) DeleteMetricExporterConnectionInfo(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_DeleteMetricExporterConnectionInfo_Call {
+	return &TransferServiceClient_DeleteMetricExporterConnectionInfo_Call{Call: _e.mock.On("DeleteMetricExporterConnectionInfo",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_DeleteMetricExporterConnectionInfo_Call) Run(run func(ctx context.Context, in *transfer.DeleteExporterConnectionInfoRequest, opts ...grpc.CallOption)) *TransferServiceClient_DeleteMetricExporterConnectionInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.DeleteExporterConnectionInfoRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_DeleteMetricExporterConnectionInfo_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TransferServiceClient_DeleteMetricExporterConnectionInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_DeleteMetricExporterConnectionInfo_Call) RunAndReturn(run func(context.Context, *transfer.DeleteExporterConnectionInfoRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TransferServiceClient_DeleteMetricExporterConnectionInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) Get(ctx context.Context, in *transfer.GetTransferRequest, opts ...grpc.CallOption) (*transfer.Transfer, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *transfer.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.GetTransferRequest, ...grpc.CallOption) (*transfer.Transfer, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.GetTransferRequest, ...grpc.CallOption) *transfer.Transfer); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transfer.Transfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.GetTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TransferServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.GetTransferRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_Get_Call {
+	return &TransferServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_Get_Call) Run(run func(ctx context.Context, in *transfer.GetTransferRequest, opts ...grpc.CallOption)) *TransferServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.GetTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_Get_Call) Return(_a0 *transfer.Transfer, _a1 error) *TransferServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_Get_Call) RunAndReturn(run func(context.Context, *transfer.GetTransferRequest, ...grpc.CallOption) (*transfer.Transfer, error)) *TransferServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetricExporterConnectionInfo provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) GetMetricExporterConnectionInfo(ctx context.Context, in *transfer.MetricExporterConnectionInfoRequest, opts ...grpc.CallOption) (*transfer.MetricExporterConnectionInfoMetadata, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetricExporterConnectionInfo")
+	}
+
+	var r0 *transfer.MetricExporterConnectionInfoMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.MetricExporterConnectionInfoRequest, ...grpc.CallOption) (*transfer.MetricExporterConnectionInfoMetadata, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.MetricExporterConnectionInfoRequest, ...grpc.CallOption) *transfer.MetricExporterConnectionInfoMetadata); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transfer.MetricExporterConnectionInfoMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.MetricExporterConnectionInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_GetMetricExporterConnectionInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetricExporterConnectionInfo'
+type TransferServiceClient_GetMetricExporterConnectionInfo_Call struct {
+	*mock.Call
+}
+
+// GetMetricExporterConnectionInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.MetricExporterConnectionInfoRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) GetMetricExporterConnectionInfo(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_GetMetricExporterConnectionInfo_Call {
+	return &TransferServiceClient_GetMetricExporterConnectionInfo_Call{Call: _e.mock.On("GetMetricExporterConnectionInfo",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_GetMetricExporterConnectionInfo_Call) Run(run func(ctx context.Context, in *transfer.MetricExporterConnectionInfoRequest, opts ...grpc.CallOption)) *TransferServiceClient_GetMetricExporterConnectionInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.MetricExporterConnectionInfoRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_GetMetricExporterConnectionInfo_Call) Return(_a0 *transfer.MetricExporterConnectionInfoMetadata, _a1 error) *TransferServiceClient_GetMetricExporterConnectionInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_GetMetricExporterConnectionInfo_Call) RunAndReturn(run func(context.Context, *transfer.MetricExporterConnectionInfoRequest, ...grpc.CallOption) (*transfer.MetricExporterConnectionInfoMetadata, error)) *TransferServiceClient_GetMetricExporterConnectionInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetrics provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) GetMetrics(ctx context.Context, in *transfer.GetMetricsRequest, opts ...grpc.CallOption) (*transfer.TransferMetrics, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetrics")
+	}
+
+	var r0 *transfer.TransferMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.GetMetricsRequest, ...grpc.CallOption) (*transfer.TransferMetrics, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.GetMetricsRequest, ...grpc.CallOption) *transfer.TransferMetrics); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transfer.TransferMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.GetMetricsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
+type TransferServiceClient_GetMetrics_Call struct {
+	*mock.Call
+}
+
+// GetMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.GetMetricsRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) GetMetrics(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_GetMetrics_Call {
+	return &TransferServiceClient_GetMetrics_Call{Call: _e.mock.On("GetMetrics",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_GetMetrics_Call) Run(run func(ctx context.Context, in *transfer.GetMetricsRequest, opts ...grpc.CallOption)) *TransferServiceClient_GetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.GetMetricsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_GetMetrics_Call) Return(_a0 *transfer.TransferMetrics, _a1 error) *TransferServiceClient_GetMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_GetMetrics_Call) RunAndReturn(run func(context.Context, *transfer.GetMetricsRequest, ...grpc.CallOption) (*transfer.TransferMetrics, error)) *TransferServiceClient_GetMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) List(ctx context.Context, in *transfer.ListTransfersRequest, opts ...grpc.CallOption) (*transfer.ListTransfersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *transfer.ListTransfersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.ListTransfersRequest, ...grpc.CallOption) (*transfer.ListTransfersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.ListTransfersRequest, ...grpc.CallOption) *transfer.ListTransfersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transfer.ListTransfersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.ListTransfersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type TransferServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.ListTransfersRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) List(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_List_Call {
+	return &TransferServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_List_Call) Run(run func(ctx context.Context, in *transfer.ListTransfersRequest, opts ...grpc.CallOption)) *TransferServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.ListTransfersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_List_Call) Return(_a0 *transfer.ListTransfersResponse, _a1 error) *TransferServiceClient_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_List_Call) RunAndReturn(run func(context.Context, *transfer.ListTransfersRequest, ...grpc.CallOption) (*transfer.ListTransfersResponse, error)) *TransferServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *TransferServiceClient) Update(ctx context.Context, in *transfer.UpdateTransferRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.UpdateTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.UpdateTransferRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.UpdateTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TransferServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *transfer.UpdateTransferRequest
+//   - opts ...grpc.CallOption
+func (_e *TransferServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *TransferServiceClient_Update_Call {
+	return &TransferServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TransferServiceClient_Update_Call) Run(run func(ctx context.Context, in *transfer.UpdateTransferRequest, opts ...grpc.CallOption)) *TransferServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*transfer.UpdateTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransferServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *TransferServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferServiceClient_Update_Call) RunAndReturn(run func(context.Context, *transfer.UpdateTransferRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *TransferServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransferServiceClient creates a new instance of TransferServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransferServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransferServiceClient {
+	mock := &TransferServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
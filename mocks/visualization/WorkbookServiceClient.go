@@ -0,0 +1,855 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package visualization
+
+import (
+	context "context"
+
+	doublecloud "github.com/doublecloud/go-genproto/doublecloud/v1"
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	visualization "github.com/doublecloud/go-genproto/doublecloud/visualization/v1"
+)
+
+// WorkbookServiceClient is an autogenerated mock type for the WorkbookServiceClient type
+type WorkbookServiceClient struct {
+	mock.Mock
+}
+
+type WorkbookServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WorkbookServiceClient) EXPECT() *WorkbookServiceClient_Expecter {
+	return &WorkbookServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// AdviseDatasetFields provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) AdviseDatasetFields(ctx context.Context, in *visualization.AdviseDatasetFieldsRequest, opts ...grpc.CallOption) (*visualization.AdviseDatasetFieldsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdviseDatasetFields")
+	}
+
+	var r0 *visualization.AdviseDatasetFieldsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.AdviseDatasetFieldsRequest, ...grpc.CallOption) (*visualization.AdviseDatasetFieldsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.AdviseDatasetFieldsRequest, ...grpc.CallOption) *visualization.AdviseDatasetFieldsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*visualization.AdviseDatasetFieldsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.AdviseDatasetFieldsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_AdviseDatasetFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdviseDatasetFields'
+type WorkbookServiceClient_AdviseDatasetFields_Call struct {
+	*mock.Call
+}
+
+// AdviseDatasetFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.AdviseDatasetFieldsRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) AdviseDatasetFields(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_AdviseDatasetFields_Call {
+	return &WorkbookServiceClient_AdviseDatasetFields_Call{Call: _e.mock.On("AdviseDatasetFields",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_AdviseDatasetFields_Call) Run(run func(ctx context.Context, in *visualization.AdviseDatasetFieldsRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_AdviseDatasetFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.AdviseDatasetFieldsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_AdviseDatasetFields_Call) Return(_a0 *visualization.AdviseDatasetFieldsResponse, _a1 error) *WorkbookServiceClient_AdviseDatasetFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_AdviseDatasetFields_Call) RunAndReturn(run func(context.Context, *visualization.AdviseDatasetFieldsRequest, ...grpc.CallOption) (*visualization.AdviseDatasetFieldsResponse, error)) *WorkbookServiceClient_AdviseDatasetFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) Create(ctx context.Context, in *visualization.CreateWorkbookRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.CreateWorkbookRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.CreateWorkbookRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.CreateWorkbookRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type WorkbookServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.CreateWorkbookRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) Create(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_Create_Call {
+	return &WorkbookServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_Create_Call) Run(run func(ctx context.Context, in *visualization.CreateWorkbookRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.CreateWorkbookRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_Create_Call) Return(_a0 *doublecloud.Operation, _a1 error) *WorkbookServiceClient_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_Create_Call) RunAndReturn(run func(context.Context, *visualization.CreateWorkbookRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *WorkbookServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateConnection provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) CreateConnection(ctx context.Context, in *visualization.CreateWorkbookConnectionRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConnection")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.CreateWorkbookConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.CreateWorkbookConnectionRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.CreateWorkbookConnectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_CreateConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConnection'
+type WorkbookServiceClient_CreateConnection_Call struct {
+	*mock.Call
+}
+
+// CreateConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.CreateWorkbookConnectionRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) CreateConnection(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_CreateConnection_Call {
+	return &WorkbookServiceClient_CreateConnection_Call{Call: _e.mock.On("CreateConnection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_CreateConnection_Call) Run(run func(ctx context.Context, in *visualization.CreateWorkbookConnectionRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_CreateConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.CreateWorkbookConnectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_CreateConnection_Call) Return(_a0 *doublecloud.Operation, _a1 error) *WorkbookServiceClient_CreateConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_CreateConnection_Call) RunAndReturn(run func(context.Context, *visualization.CreateWorkbookConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *WorkbookServiceClient_CreateConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) Delete(ctx context.Context, in *visualization.DeleteWorkbookRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.DeleteWorkbookRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.DeleteWorkbookRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.DeleteWorkbookRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type WorkbookServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.DeleteWorkbookRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) Delete(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_Delete_Call {
+	return &WorkbookServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_Delete_Call) Run(run func(ctx context.Context, in *visualization.DeleteWorkbookRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.DeleteWorkbookRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_Delete_Call) Return(_a0 *doublecloud.Operation, _a1 error) *WorkbookServiceClient_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_Delete_Call) RunAndReturn(run func(context.Context, *visualization.DeleteWorkbookRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *WorkbookServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteConnection provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) DeleteConnection(ctx context.Context, in *visualization.DeleteWorkbookConnectionRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConnection")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.DeleteWorkbookConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.DeleteWorkbookConnectionRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.DeleteWorkbookConnectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_DeleteConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConnection'
+type WorkbookServiceClient_DeleteConnection_Call struct {
+	*mock.Call
+}
+
+// DeleteConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.DeleteWorkbookConnectionRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) DeleteConnection(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_DeleteConnection_Call {
+	return &WorkbookServiceClient_DeleteConnection_Call{Call: _e.mock.On("DeleteConnection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_DeleteConnection_Call) Run(run func(ctx context.Context, in *visualization.DeleteWorkbookConnectionRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_DeleteConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.DeleteWorkbookConnectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_DeleteConnection_Call) Return(_a0 *doublecloud.Operation, _a1 error) *WorkbookServiceClient_DeleteConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_DeleteConnection_Call) RunAndReturn(run func(context.Context, *visualization.DeleteWorkbookConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *WorkbookServiceClient_DeleteConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) Get(ctx context.Context, in *visualization.GetWorkbookRequest, opts ...grpc.CallOption) (*visualization.GetWorkbookResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *visualization.GetWorkbookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.GetWorkbookRequest, ...grpc.CallOption) (*visualization.GetWorkbookResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.GetWorkbookRequest, ...grpc.CallOption) *visualization.GetWorkbookResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*visualization.GetWorkbookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.GetWorkbookRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type WorkbookServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.GetWorkbookRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) Get(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_Get_Call {
+	return &WorkbookServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_Get_Call) Run(run func(ctx context.Context, in *visualization.GetWorkbookRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.GetWorkbookRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_Get_Call) Return(_a0 *visualization.GetWorkbookResponse, _a1 error) *WorkbookServiceClient_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_Get_Call) RunAndReturn(run func(context.Context, *visualization.GetWorkbookRequest, ...grpc.CallOption) (*visualization.GetWorkbookResponse, error)) *WorkbookServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnection provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) GetConnection(ctx context.Context, in *visualization.GetWorkbookConnectionRequest, opts ...grpc.CallOption) (*visualization.GetWorkbookConnectionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnection")
+	}
+
+	var r0 *visualization.GetWorkbookConnectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.GetWorkbookConnectionRequest, ...grpc.CallOption) (*visualization.GetWorkbookConnectionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.GetWorkbookConnectionRequest, ...grpc.CallOption) *visualization.GetWorkbookConnectionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*visualization.GetWorkbookConnectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.GetWorkbookConnectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_GetConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnection'
+type WorkbookServiceClient_GetConnection_Call struct {
+	*mock.Call
+}
+
+// GetConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.GetWorkbookConnectionRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) GetConnection(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_GetConnection_Call {
+	return &WorkbookServiceClient_GetConnection_Call{Call: _e.mock.On("GetConnection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_GetConnection_Call) Run(run func(ctx context.Context, in *visualization.GetWorkbookConnectionRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_GetConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.GetWorkbookConnectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_GetConnection_Call) Return(_a0 *visualization.GetWorkbookConnectionResponse, _a1 error) *WorkbookServiceClient_GetConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_GetConnection_Call) RunAndReturn(run func(context.Context, *visualization.GetWorkbookConnectionRequest, ...grpc.CallOption) (*visualization.GetWorkbookConnectionResponse, error)) *WorkbookServiceClient_GetConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetErrorDetails provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) GetErrorDetails(ctx context.Context, in *visualization.ErrorDetailsRequest, opts ...grpc.CallOption) (*visualization.ErrorDetailsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetErrorDetails")
+	}
+
+	var r0 *visualization.ErrorDetailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.ErrorDetailsRequest, ...grpc.CallOption) (*visualization.ErrorDetailsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.ErrorDetailsRequest, ...grpc.CallOption) *visualization.ErrorDetailsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*visualization.ErrorDetailsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.ErrorDetailsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_GetErrorDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetErrorDetails'
+type WorkbookServiceClient_GetErrorDetails_Call struct {
+	*mock.Call
+}
+
+// GetErrorDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.ErrorDetailsRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) GetErrorDetails(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_GetErrorDetails_Call {
+	return &WorkbookServiceClient_GetErrorDetails_Call{Call: _e.mock.On("GetErrorDetails",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_GetErrorDetails_Call) Run(run func(ctx context.Context, in *visualization.ErrorDetailsRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_GetErrorDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.ErrorDetailsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_GetErrorDetails_Call) Return(_a0 *visualization.ErrorDetailsResponse, _a1 error) *WorkbookServiceClient_GetErrorDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_GetErrorDetails_Call) RunAndReturn(run func(context.Context, *visualization.ErrorDetailsRequest, ...grpc.CallOption) (*visualization.ErrorDetailsResponse, error)) *WorkbookServiceClient_GetErrorDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkbooks provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) ListWorkbooks(ctx context.Context, in *visualization.ListWorkbooksRequest, opts ...grpc.CallOption) (*visualization.ListWorkbooksResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkbooks")
+	}
+
+	var r0 *visualization.ListWorkbooksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.ListWorkbooksRequest, ...grpc.CallOption) (*visualization.ListWorkbooksResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.ListWorkbooksRequest, ...grpc.CallOption) *visualization.ListWorkbooksResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*visualization.ListWorkbooksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.ListWorkbooksRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_ListWorkbooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkbooks'
+type WorkbookServiceClient_ListWorkbooks_Call struct {
+	*mock.Call
+}
+
+// ListWorkbooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.ListWorkbooksRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) ListWorkbooks(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_ListWorkbooks_Call {
+	return &WorkbookServiceClient_ListWorkbooks_Call{Call: _e.mock.On("ListWorkbooks",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_ListWorkbooks_Call) Run(run func(ctx context.Context, in *visualization.ListWorkbooksRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_ListWorkbooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.ListWorkbooksRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_ListWorkbooks_Call) Return(_a0 *visualization.ListWorkbooksResponse, _a1 error) *WorkbookServiceClient_ListWorkbooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_ListWorkbooks_Call) RunAndReturn(run func(context.Context, *visualization.ListWorkbooksRequest, ...grpc.CallOption) (*visualization.ListWorkbooksResponse, error)) *WorkbookServiceClient_ListWorkbooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) Update(ctx context.Context, in *visualization.UpdateWorkbookRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.UpdateWorkbookRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.UpdateWorkbookRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.UpdateWorkbookRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type WorkbookServiceClient_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.UpdateWorkbookRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) Update(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_Update_Call {
+	return &WorkbookServiceClient_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_Update_Call) Run(run func(ctx context.Context, in *visualization.UpdateWorkbookRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.UpdateWorkbookRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_Update_Call) Return(_a0 *doublecloud.Operation, _a1 error) *WorkbookServiceClient_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_Update_Call) RunAndReturn(run func(context.Context, *visualization.UpdateWorkbookRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *WorkbookServiceClient_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConnection provides a mock function with given fields: ctx, in, opts
+func (_m *WorkbookServiceClient) UpdateConnection(ctx context.Context, in *visualization.UpdateWorkbookConnectionRequest, opts ...grpc.CallOption) (*doublecloud.Operation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnection")
+	}
+
+	var r0 *doublecloud.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.UpdateWorkbookConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *visualization.UpdateWorkbookConnectionRequest, ...grpc.CallOption) *doublecloud.Operation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*doublecloud.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *visualization.UpdateWorkbookConnectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkbookServiceClient_UpdateConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnection'
+type WorkbookServiceClient_UpdateConnection_Call struct {
+	*mock.Call
+}
+
+// UpdateConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *visualization.UpdateWorkbookConnectionRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkbookServiceClient_Expecter) UpdateConnection(ctx interface{}, in interface{}, opts ...interface{}) *WorkbookServiceClient_UpdateConnection_Call {
+	return &WorkbookServiceClient_UpdateConnection_Call{Call: _e.mock.On("UpdateConnection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkbookServiceClient_UpdateConnection_Call) Run(run func(ctx context.Context, in *visualization.UpdateWorkbookConnectionRequest, opts ...grpc.CallOption)) *WorkbookServiceClient_UpdateConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*visualization.UpdateWorkbookConnectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *WorkbookServiceClient_UpdateConnection_Call) Return(_a0 *doublecloud.Operation, _a1 error) *WorkbookServiceClient_UpdateConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkbookServiceClient_UpdateConnection_Call) RunAndReturn(run func(context.Context, *visualization.UpdateWorkbookConnectionRequest, ...grpc.CallOption) (*doublecloud.Operation, error)) *WorkbookServiceClient_UpdateConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWorkbookServiceClient creates a new instance of WorkbookServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorkbookServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WorkbookServiceClient {
+	mock := &WorkbookServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
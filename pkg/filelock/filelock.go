@@ -0,0 +1,43 @@
+package filelock
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+const retryInterval = 50 * time.Millisecond
+
+// Lock takes an exclusive advisory lock on the file at path, creating it if needed.
+// Lock blocks until the lock is acquired or ctx is done. The lock is shared between processes
+// and released by the returned unlock function.
+func Lock(ctx context.Context, path string) (unlock func() error, err error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		locked, err := tryLock(f)
+		if err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+		if locked {
+			return func() error {
+				if err := unlockFile(f); err != nil {
+					_ = f.Close()
+					return err
+				}
+				return f.Close()
+			}, nil
+		}
+		timer := time.NewTimer(retryInterval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			_ = f.Close()
+			return nil, ctx.Err()
+		}
+	}
+}
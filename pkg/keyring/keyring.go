@@ -0,0 +1,68 @@
+package keyring
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// ErrNotFound is returned by Get when there is no secret for the given service and account.
+var ErrNotFound = errors.New("keyring: secret not found")
+
+func Supported() bool {
+	return supported()
+}
+
+// Get returns the secret stored in OS keyring for service and account.
+func Get(service, account string) (string, error) {
+	return get(service, account)
+}
+
+// Set stores the secret in OS keyring, replacing the existing one.
+func Set(service, account, secret string) error {
+	return set(service, account, secret)
+}
+
+// Delete removes the secret from OS keyring. Deleting a missing secret is not an error.
+func Delete(service, account string) error {
+	return del(service, account)
+}
+
+// runCmd runs prog with stdin and returns its trimmed stdout.
+// Secrets are passed via stdin only, so they are never visible in the process list.
+func runCmd(stdin string, prog string, args ...string) (string, error) {
+	cmd := exec.Command(prog, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", &cmdError{err: err, stderr: msg}
+		}
+		return "", err
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+type cmdError struct {
+	err    error
+	stderr string
+}
+
+func (e *cmdError) Error() string {
+	return e.err.Error() + ": " + e.stderr
+}
+
+func (e *cmdError) Unwrap() error {
+	return e.err
+}
+
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
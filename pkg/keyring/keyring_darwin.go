@@ -0,0 +1,43 @@
+//go:build darwin
+// +build darwin
+
+package keyring
+
+import (
+	"os/exec"
+	"strconv"
+)
+
+const securityCmd = "security"
+
+// errSecItemNotFound is the exit code of security tool when item is missing.
+const errSecItemNotFound = 44
+
+func supported() bool {
+	_, err := exec.LookPath(securityCmd)
+	return err == nil
+}
+
+func get(service, account string) (string, error) {
+	out, err := runCmd("", securityCmd, "find-generic-password", "-s", service, "-a", account, "-w")
+	if exitCode(err) == errSecItemNotFound {
+		return "", ErrNotFound
+	}
+	return out, err
+}
+
+func set(service, account, secret string) error {
+	// Interactive mode reads commands from stdin, so the secret does not appear in process arguments.
+	command := "add-generic-password -U -s " + strconv.Quote(service) + " -a " + strconv.Quote(account) +
+		" -w " + strconv.Quote(secret) + "\n"
+	_, err := runCmd(command, securityCmd, "-i")
+	return err
+}
+
+func del(service, account string) error {
+	_, err := runCmd("", securityCmd, "delete-generic-password", "-s", service, "-a", account)
+	if exitCode(err) == errSecItemNotFound {
+		return nil
+	}
+	return err
+}
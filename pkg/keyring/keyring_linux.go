@@ -0,0 +1,35 @@
+//go:build linux
+// +build linux
+
+package keyring
+
+import "os/exec"
+
+// secretToolCmd is a client of freedesktop.org Secret Service (GNOME Keyring, KWallet).
+const secretToolCmd = "secret-tool"
+
+func supported() bool {
+	_, err := exec.LookPath(secretToolCmd)
+	return err == nil
+}
+
+func get(service, account string) (string, error) {
+	out, err := runCmd("", secretToolCmd, "lookup", "service", service, "account", account)
+	if (err == nil && out == "") || exitCode(err) == 1 {
+		return "", ErrNotFound
+	}
+	return out, err
+}
+
+func set(service, account, secret string) error {
+	_, err := runCmd(secret, secretToolCmd, "store", "--label="+service+" "+account, "service", service, "account", account)
+	return err
+}
+
+func del(service, account string) error {
+	_, err := runCmd("", secretToolCmd, "clear", "service", service, "account", account)
+	if exitCode(err) == 1 {
+		return nil
+	}
+	return err
+}
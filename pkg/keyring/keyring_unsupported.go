@@ -0,0 +1,25 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package keyring
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func supported() bool {
+	return false
+}
+
+func get(service, account string) (string, error) {
+	return "", fmt.Errorf("keyring: unsupported operating system: %v", runtime.GOOS)
+}
+
+func set(service, account, secret string) error {
+	return fmt.Errorf("keyring: unsupported operating system: %v", runtime.GOOS)
+}
+
+func del(service, account string) error {
+	return fmt.Errorf("keyring: unsupported operating system: %v", runtime.GOOS)
+}
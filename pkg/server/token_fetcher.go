@@ -2,10 +2,15 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"log"
 	"net"
 	"net/http"
+	"net/url"
+	"path"
 	"sync"
 	"time"
 
@@ -13,32 +18,157 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
+const (
+	// DefaultCallbackPath is a path of the local server that receives token after authentication.
+	DefaultCallbackPath = "/callback"
+
+	defaultSuccessHTML = `<!DOCTYPE html>
+<html><head><title>DoubleCloud</title></head>
+<body><p>Authentication finished. You can close this window.</p></body></html>
+`
+	shutdownTimeout = 5 * time.Second
+)
+
+var (
+	ErrInvalidState    = errors.New("invalid or missing state in federation callback path")
+	ErrAlreadyReceived = errors.New("federation callback has already been received")
+)
+
+// URLRequestFunc opens federation login page that redirects to the local server at serverAddr.
+//
+// Deprecated: use RedirectURLRequestFunc with Fetch, that verifies callback state.
 type URLRequestFunc func(serverAddr string) error
 
+// RedirectURLRequestFunc opens federation login page that redirects to redirectURL after authentication.
+// The last segment of redirectURL path is the state of the login, unless WithoutState is used,
+// so redirectURL must be passed to federation as is.
+type RedirectURLRequestFunc func(redirectURL string) error
+
+type Option func(*options)
+
+type options struct {
+	addr         string
+	callbackPath string
+	consoleURL   string
+	successHTML  string
+	noState      bool
+}
+
+// WithAddr sets address the local server listens on, e.g. "127.0.0.1:8085".
+// Random port on loopback interface is used by default.
+func WithAddr(addr string) Option {
+	return func(o *options) {
+		o.addr = addr
+	}
+}
+
+// WithCallbackPath overrides DefaultCallbackPath.
+func WithCallbackPath(path string) Option {
+	return func(o *options) {
+		o.callbackPath = path
+	}
+}
+
+// WithConsoleURL makes the local server redirect browser to consoleURL after successful authentication.
+func WithConsoleURL(consoleURL string) Option {
+	return func(o *options) {
+		o.consoleURL = consoleURL
+	}
+}
+
+// WithSuccessHTML makes the local server respond with the page after successful authentication
+// instead of redirecting to console.
+func WithSuccessHTML(html string) Option {
+	return func(o *options) {
+		o.successHTML = html
+	}
+}
+
+// WithoutState makes the local server accept callbacks without state of the login at callback path.
+// It is insecure: any local process may complete the login with its own token.
+func WithoutState() Option {
+	return func(o *options) {
+		o.noState = true
+	}
+}
+
+// GetToken starts local server, calls f with its address and waits for the token.
+//
+// Deprecated: use Fetch, that verifies callback state.
 func GetToken(ctx context.Context, f URLRequestFunc, consoleURL string) (*Token, error) {
-	srv, err := serve(consoleURL)
+	return fetch(ctx, func(redirectURL string) error {
+		u, err := url.Parse(redirectURL)
+		if err != nil {
+			return err
+		}
+		return f(u.Host)
+	}, &options{callbackPath: "/", consoleURL: consoleURL, noState: true})
+}
+
+// Fetch starts local server, calls f with its redirect URL and waits until browser is redirected
+// to it with the token. Callbacks without the state generated for this call are rejected, see WithoutState.
+// Returns Token.Err if federation callback has no valid token, or ctx error if ctx is done before callback.
+func Fetch(ctx context.Context, f RedirectURLRequestFunc, opts ...Option) (*Token, error) {
+	o := &options{callbackPath: DefaultCallbackPath}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return fetch(ctx, f, o)
+}
+
+func fetch(ctx context.Context, f RedirectURLRequestFunc, o *options) (*Token, error) {
+	srv, err := serve(o)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
 
-	err = f(srv.Addr().String())
+	err = f(srv.RedirectURL())
 	if err != nil {
-		_ = srv.Shutdown(ctx)
 		return nil, err
 	}
 
 	select {
 	case <-srv.Served():
 	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 
-	// ensure server shutdown before reading Token from the struct
-	_ = srv.Shutdown(ctx)
+	token := srv.Token()
+	if token.Err != nil {
+		return nil, token.Err
+	}
+	return token, nil
+}
 
-	return srv.Token(), err
+// NewState generates random state for a single login.
+func NewState() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// StatePath returns callback path bound to the login with state. State is kept in path rather than in query,
+// so it survives federation that replaces query of the redirect URL with token parameters.
+func StatePath(callbackPath, state string) string {
+	return path.Join(callbackPath, state)
+}
+
+// CheckState reports whether callback URL u has path bound to the login with state, see StatePath.
+func CheckState(u *url.URL, state string) bool {
+	actual := path.Base(u.Path)
+	return state != "" && subtle.ConstantTimeCompare([]byte(actual), []byte(state)) == 1
 }
 
 func (s *server) Token() *Token {
+	s.mux.Lock()
+	defer s.mux.Unlock()
 	return s.token
 }
 
@@ -53,32 +183,51 @@ type Token struct {
 }
 
 type server struct {
-	srv        http.Server
-	lsn        net.Listener
-	err        chan error
-	served     chan struct{}
-	token      *Token
-	mux        sync.Mutex
-	logger     log.Logger
-	consoleURL string
+	opts   *options
+	state  string
+	srv    http.Server
+	lsn    net.Listener
+	err    chan error
+	served chan struct{}
+	token  *Token
+	mux    sync.Mutex
 }
 
 func (s *server) Addr() net.Addr {
 	return s.lsn.Addr()
 }
 
+// RedirectURL returns URL of the callback handler, bound to the login with state, see StatePath.
+func (s *server) RedirectURL() string {
+	u := url.URL{Scheme: "http", Host: s.Addr().String(), Path: s.handlerPath()}
+	return u.String()
+}
+
+func (s *server) handlerPath() string {
+	if s.opts.noState {
+		return s.opts.callbackPath
+	}
+	return StatePath(s.opts.callbackPath, s.state)
+}
+
 func (s *server) Serve() error {
 	var err error
-	s.lsn, err = listener()
+	if !s.opts.noState {
+		if s.state, err = NewState(); err != nil {
+			return err
+		}
+	}
+	s.lsn, err = listener(s.opts.addr)
 	if err != nil {
 		return err
 	}
 
-	s.err = make(chan error)
-	s.served = make(chan struct{}, 1)
+	s.err = make(chan error, 1)
+	s.served = make(chan struct{})
 	mux := http.NewServeMux()
-	mux.Handle("/", s)
+	mux.Handle(s.handlerPath(), s)
 	s.srv.Handler = mux
+	s.srv.ReadHeaderTimeout = 10 * time.Second
 	go func() {
 		s.err <- s.srv.Serve(s.lsn)
 	}()
@@ -104,59 +253,79 @@ func (s *server) Shutdown(ctx context.Context) error {
 }
 
 func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.opts.noState && !CheckState(r.URL, s.state) {
+		// Do not finish the login: the request was not issued by the federation for this login.
+		http.Error(w, ErrInvalidState.Error(), http.StatusBadRequest)
+		return
+	}
+
 	s.mux.Lock()
 	defer s.mux.Unlock()
 	if s.token != nil {
+		http.Error(w, ErrAlreadyReceived.Error(), http.StatusConflict)
 		return
 	}
 
-	defer redirectToConsole(w, r, s.consoleURL)
+	s.token = ParseToken(r.URL.Query())
+	close(s.served)
 
-	s.token = &Token{}
-	defer func() {
-		close(s.served)
-	}()
+	if s.token.Err != nil {
+		http.Error(w, "Authentication failed: "+s.token.Err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.respondSuccess(w, r)
+}
 
-	flags := r.URL.Query()
+func (s *server) respondSuccess(w http.ResponseWriter, r *http.Request) {
+	if s.opts.successHTML == "" && s.opts.consoleURL != "" {
+		http.Redirect(w, r, s.opts.consoleURL, http.StatusSeeOther)
+		return
+	}
+	html := s.opts.successHTML
+	if html == "" {
+		html = defaultSuccessHTML
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write([]byte(html))
+}
+
+// ParseToken parses token from query parameters of the federation redirect URL.
+// Parse errors are reported in Token.Err.
+func ParseToken(flags url.Values) *Token {
+	token := &Token{}
 
 	tokens, ok := flags["token"]
 	if !ok || len(tokens) != 1 {
-		s.token.Err = fmt.Errorf("failed to fetch token from http request")
-		return
+		token.Err = fmt.Errorf("failed to fetch token from http request")
+		return token
 	}
 
-	s.token.IamToken = tokens[0]
+	token.IamToken = tokens[0]
 
 	times, ok := flags["expiresAt"]
 	if !ok || len(times) != 1 {
-		s.token.Err = fmt.Errorf("failed to fetch token expiration timestamp from http request")
-		return
+		token.Err = fmt.Errorf("failed to fetch token expiration timestamp from http request")
+		return token
 	}
 
 	t, err := time.Parse(time.RFC3339, times[0])
 	if err != nil {
-		s.token.Err = fmt.Errorf("failed to parse token expiration timestamp from: %s, %v", times[0], err)
-		return
+		token.Err = fmt.Errorf("failed to parse token expiration timestamp from: %s, %v", times[0], err)
+		return token
 	}
 
-	s.token.ExpiresAt, err = ptypes.TimestampProto(t)
+	token.ExpiresAt, err = ptypes.TimestampProto(t)
 	if err != nil {
-		s.token.Err = fmt.Errorf("failed to parse token expiration timestamp from: %s, %v", times[0], err)
-		return
+		token.Err = fmt.Errorf("failed to parse token expiration timestamp from: %s, %v", times[0], err)
+		return token
 	}
+	return token
 }
 
-func redirectToConsole(w http.ResponseWriter, r *http.Request, consoleURL string) {
-	if consoleURL == "" {
-		return
-	}
-
-	http.Redirect(w, r, consoleURL, http.StatusSeeOther)
-}
-
-func serve(consoleURL string) (*server, error) {
+func serve(opts *options) (*server, error) {
 	srv := &server{
-		consoleURL: consoleURL,
+		opts: opts,
 	}
 	err := srv.Serve()
 	if err != nil {
@@ -166,7 +335,10 @@ func serve(consoleURL string) (*server, error) {
 	return srv, nil
 }
 
-func listener() (net.Listener, error) {
+func listener(addr string) (net.Listener, error) {
+	if addr != "" {
+		return net.Listen("tcp", addr)
+	}
 	l, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
 		if l, err = net.Listen("tcp6", "[::1]:0"); err != nil {
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testExpiresAt = "2030-01-02T03:04:05Z"
+
+func newTestServer(opts *options) *server {
+	return &server{opts: opts, state: "expected-state", served: make(chan struct{})}
+}
+
+func callbackRequest(path string, query url.Values) *http.Request {
+	return httptest.NewRequest(http.MethodGet, (&url.URL{Path: path, RawQuery: query.Encode()}).String(), nil)
+}
+
+func TestServeHTTP_InvalidState(t *testing.T) {
+	s := newTestServer(&options{callbackPath: DefaultCallbackPath})
+	for _, path := range []string{DefaultCallbackPath, DefaultCallbackPath + "/wrong-state"} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, callbackRequest(path, url.Values{
+			"token":     {"iam-token"},
+			"expiresAt": {testExpiresAt},
+		}))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+	assert.Nil(t, s.Token(), "callback with invalid state must not finish the login")
+}
+
+func TestServeHTTP_SuccessHTML(t *testing.T) {
+	s := newTestServer(&options{callbackPath: DefaultCallbackPath, consoleURL: "https://app.double.cloud", successHTML: "<p>done</p>"})
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, callbackRequest(DefaultCallbackPath+"/expected-state", url.Values{
+		"token":     {"iam-token"},
+		"expiresAt": {testExpiresAt},
+	}))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<p>done</p>", w.Body.String())
+
+	token := s.Token()
+	require.NoError(t, token.Err)
+	assert.Equal(t, "iam-token", token.IamToken)
+	assert.Equal(t, testExpiresAt, token.ExpiresAt.AsTime().Format(time.RFC3339))
+
+	w = httptest.NewRecorder()
+	s.ServeHTTP(w, callbackRequest(DefaultCallbackPath+"/expected-state", url.Values{}))
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestServeHTTP_RedirectToConsole(t *testing.T) {
+	s := newTestServer(&options{callbackPath: DefaultCallbackPath, consoleURL: "https://app.double.cloud"})
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, callbackRequest(DefaultCallbackPath+"/expected-state", url.Values{
+		"token":     {"iam-token"},
+		"expiresAt": {testExpiresAt},
+	}))
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	assert.Equal(t, "https://app.double.cloud", w.Header().Get("Location"))
+}
+
+func TestFetch(t *testing.T) {
+	token, err := Fetch(context.Background(), func(redirectURL string) error {
+		u, err := url.Parse(redirectURL)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(u.Path, DefaultCallbackPath+"/"), u.Path)
+		assert.Empty(t, u.RawQuery)
+		// Federation appends token parameters to the redirect URL.
+		u.RawQuery = url.Values{"token": {"iam-token"}, "expiresAt": {testExpiresAt}}.Encode()
+		go func() {
+			resp, err := http.Get(u.String())
+			if assert.NoError(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+		return nil
+	}, WithSuccessHTML("ok"))
+	require.NoError(t, err)
+	assert.Equal(t, "iam-token", token.IamToken)
+}
+
+func TestFetch_WithoutState(t *testing.T) {
+	token, err := Fetch(context.Background(), func(redirectURL string) error {
+		u, err := url.Parse(redirectURL)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultCallbackPath, u.Path)
+		go func() {
+			resp, err := http.Get(redirectURL + "?token=iam-token&expiresAt=" + testExpiresAt)
+			if assert.NoError(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+		return nil
+	}, WithoutState(), WithSuccessHTML("ok"))
+	require.NoError(t, err)
+	assert.Equal(t, "iam-token", token.IamToken)
+}
+
+func TestFetch_TokenError(t *testing.T) {
+	_, err := Fetch(context.Background(), func(redirectURL string) error {
+		go func() {
+			resp, err := http.Get(redirectURL + "?error=access_denied")
+			if assert.NoError(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+		return nil
+	})
+	assert.Error(t, err)
+}
+
+func TestFetch_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	token, err := Fetch(ctx, func(redirectURL string) error { return nil })
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, token)
+}
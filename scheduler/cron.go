@@ -0,0 +1,139 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a cron schedule with 5 fields: minute, hour, day of month, month and day of week, e.g.
+// "0 20 * * 1-5" is 20:00 on workdays. A field is "*", a number, a range "a-b", a step "*/n" or "a-b/n",
+// or a comma-separated list of them. Day of week is 0-7, both 0 and 7 are Sunday. As in cron,
+// if both day of month and day of week are restricted, a day matching either of them matches.
+type Schedule struct {
+	expr     string
+	minute   []bool
+	hour     []bool
+	dom      []bool
+	month    []bool
+	dow      []bool
+	anyDom   bool
+	anyDow   bool
+	location *time.Location
+}
+
+// ParseSchedule parses cron expression, times are matched in location loc.
+func ParseSchedule(expr string, loc *time.Location) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule %q: 5 fields expected, got %d", expr, len(fields))
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	s := &Schedule{expr: expr, location: loc}
+	var err error
+	for _, f := range []struct {
+		name     string
+		field    string
+		min, max int
+		set      *[]bool
+	}{
+		{"minute", fields[0], 0, 59, &s.minute},
+		{"hour", fields[1], 0, 23, &s.hour},
+		{"day of month", fields[2], 1, 31, &s.dom},
+		{"month", fields[3], 1, 12, &s.month},
+		{"day of week", fields[4], 0, 7, &s.dow},
+	} {
+		if *f.set, err = parseField(f.field, f.min, f.max); err != nil {
+			return nil, fmt.Errorf("schedule %q: %s: %w", expr, f.name, err)
+		}
+	}
+	s.dow[0] = s.dow[0] || s.dow[7]
+	s.anyDom = fields[2] == "*"
+	s.anyDow = fields[4] == "*"
+	return s, nil
+}
+
+func parseField(field string, min, max int) ([]bool, error) {
+	set := make([]bool, max+1)
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			var err error
+			rng = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+		}
+		lo, hi := min, max
+		if rng != "*" {
+			bounds := strings.SplitN(rng, "-", 2)
+			var err error
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, fmt.Errorf("invalid value in %q", part)
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return nil, fmt.Errorf("invalid value in %q", part)
+				}
+			} else if step > 1 {
+				hi = max
+			}
+			if lo < min || hi > max || lo > hi {
+				return nil, fmt.Errorf("%q out of range %d-%d", part, min, max)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+// String returns the cron expression.
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Matches reports whether the schedule fires at minute of t.
+func (s *Schedule) Matches(t time.Time) bool {
+	t = t.In(s.location)
+	return s.minute[t.Minute()] && s.hour[t.Hour()] && s.dayMatches(t)
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	if !s.month[t.Month()] {
+		return false
+	}
+	dom, dow := s.dom[t.Day()], s.dow[t.Weekday()]
+	switch {
+	case s.anyDom:
+		return dow
+	case s.anyDow:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// Next returns the first time after t the schedule fires at. Returns zero time if it never fires,
+// e.g. on February 30.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.In(s.location).Truncate(time.Minute).Add(time.Minute)
+	for limit := t.AddDate(5, 0, 0); t.Before(limit); {
+		switch {
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+		case !s.hour[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.location)
+		case !s.minute[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
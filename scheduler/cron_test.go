@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+	} {
+		_, err := ParseSchedule(expr, nil)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2024, 6, 12, 12, 30, 15, 0, time.UTC)
+	for expr, next := range map[string]time.Time{
+		"* * * * *":        time.Date(2024, 6, 12, 12, 31, 0, 0, time.UTC),
+		"0 20 * * 1-5":     time.Date(2024, 6, 12, 20, 0, 0, 0, time.UTC),
+		"0 8 * * 1-5":      time.Date(2024, 6, 13, 8, 0, 0, 0, time.UTC),
+		"*/15 12 * * *":    time.Date(2024, 6, 12, 12, 45, 0, 0, time.UTC),
+		"0 0 * * 7":        time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC),
+		"0 0 * * 0":        time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC),
+		"0 9 1,15 * *":     time.Date(2024, 6, 15, 9, 0, 0, 0, time.UTC),
+		"0 9 1 * 1":        time.Date(2024, 6, 17, 9, 0, 0, 0, time.UTC),
+		"30 6 29 2 *":      time.Date(2028, 2, 29, 6, 30, 0, 0, time.UTC),
+		"0 0 30 2 *":       {},
+		"10-20/5 13 * * *": time.Date(2024, 6, 12, 13, 10, 0, 0, time.UTC),
+	} {
+		s, err := ParseSchedule(expr, nil)
+		require.NoError(t, err, expr)
+		assert.Equal(t, next, s.Next(now), expr)
+		if !next.IsZero() {
+			assert.True(t, s.Matches(next), expr)
+			assert.False(t, s.Matches(next.Add(-time.Minute)) && expr != "* * * * *", expr)
+		}
+	}
+}
+
+func TestSchedule_Location(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	s, err := ParseSchedule("0 20 * * *", loc)
+	require.NoError(t, err)
+	assert.True(t, s.Matches(time.Date(2024, 6, 12, 17, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 6, 12, 17, 0, 0, 0, time.UTC), s.Next(time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)).UTC())
+}
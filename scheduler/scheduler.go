@@ -0,0 +1,492 @@
+// Package scheduler stops and starts ClickHouse and Kafka clusters on schedule,
+// e.g. to stop development clusters for the night:
+//
+//	s, err := scheduler.New(sdk.API(), scheduler.Config{
+//		Rules: []scheduler.Rule{{
+//			Name:     "dev",
+//			Selector: scheduler.Selector{Kind: scheduler.KindClickHouse, ProjectID: projectID, Names: []string{"dev-*"}},
+//			Stop:     "0 20 * * 1-5",
+//			Start:    "0 8 * * 1-5",
+//		}},
+//	})
+//	err = s.Run(ctx, func(r *scheduler.Report) { log.Print(r) })
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/maintenance"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	multierror "github.com/hashicorp/go-multierror"
+	"golang.org/x/sync/errgroup"
+)
+
+// Kind is a kind of cluster.
+type Kind = maintenance.Kind
+
+const (
+	KindClickHouse = maintenance.KindClickHouse
+	KindKafka      = maintenance.KindKafka
+)
+
+// Action is an action the scheduler performs on a cluster.
+type Action string
+
+const (
+	ActionStop  Action = "stop"
+	ActionStart Action = "start"
+)
+
+// Outcome is a result of an action on a cluster.
+type Outcome string
+
+const (
+	OutcomeDone    Outcome = "done"
+	OutcomeSkipped Outcome = "skipped"
+	OutcomeFailed  Outcome = "failed"
+)
+
+// DefaultMaintenanceMargin is used if Config.MaintenanceMargin is not set.
+const DefaultMaintenanceMargin = time.Hour
+
+// Selector selects clusters of a kind. Clusters have no labels, so they are selected by ID or name.
+type Selector struct {
+	Kind Kind
+	// ClusterIDs are IDs of selected clusters.
+	ClusterIDs []string
+	// ProjectID and Names select clusters of the project which names match any of path.Match patterns,
+	// e.g. "dev-*". All clusters of the project are selected if Names are empty.
+	ProjectID string
+	Names     []string
+}
+
+// Rule stops and starts clusters of Selector on cron schedules, see Schedule.
+// If both schedules fire at the same minute, clusters are stopped.
+type Rule struct {
+	Name     string
+	Selector Selector
+	// Stop and Start are cron expressions, either may be empty.
+	Stop  string
+	Start string
+}
+
+// Config configures Scheduler.
+type Config struct {
+	Rules []Rule
+	// Location is a time zone of schedules.
+	// Default value: time.UTC
+	Location *time.Location
+	// MaintenanceMargin is how long before and after planned maintenance of a cluster it is not touched.
+	// Default value: DefaultMaintenanceMargin
+	MaintenanceMargin time.Duration
+	// Concurrency is how many clusters are stopped or started at once.
+	// Default value: 4
+	Concurrency int
+}
+
+// Result is a result of an action on a cluster. ClusterID is empty if the clusters of the rule
+// could not be listed.
+type Result struct {
+	Rule        string
+	Kind        Kind
+	ClusterID   string
+	ClusterName string
+	Action      Action
+	Outcome     Outcome
+	// Reason explains why action was skipped.
+	Reason string
+	Err    error
+}
+
+func (r *Result) String() string {
+	cluster := r.ClusterID
+	if r.ClusterName != "" {
+		cluster = fmt.Sprintf("%s (%s)", r.ClusterID, r.ClusterName)
+	}
+	s := fmt.Sprintf("%s: %s %s %s: %s", r.Rule, r.Action, r.Kind, cluster, r.Outcome)
+	switch {
+	case r.Err != nil:
+		s += ": " + r.Err.Error()
+	case r.Reason != "":
+		s += ": " + r.Reason
+	}
+	return s
+}
+
+// Report is a result of a scheduler run.
+type Report struct {
+	Time    time.Time
+	Results []*Result
+}
+
+// Err returns errors of failed actions.
+func (r *Report) Err() error {
+	var errs error
+	for _, res := range r.Results {
+		if res.Err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("%s %s %s: %w", res.Action, res.Kind, res.ClusterID, res.Err))
+		}
+	}
+	return errs
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "scheduler run at %s: %d actions", r.Time.Format(time.RFC3339), len(r.Results))
+	for _, res := range r.Results {
+		b.WriteString("\n  ")
+		b.WriteString(res.String())
+	}
+	return b.String()
+}
+
+type rule struct {
+	Rule
+	stop, start *Schedule
+}
+
+// Scheduler stops and starts clusters by rules. It is safe for concurrent use.
+type Scheduler struct {
+	api   dcsdk.API
+	cfg   Config
+	rules []*rule
+	// now may be replaced in tests
+	now func() time.Time
+}
+
+// New creates Scheduler that uses api. Schedules of rules are parsed and validated.
+func New(api dcsdk.API, cfg Config) (*Scheduler, error) {
+	if cfg.Location == nil {
+		cfg.Location = time.UTC
+	}
+	if cfg.MaintenanceMargin == 0 {
+		cfg.MaintenanceMargin = DefaultMaintenanceMargin
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 4
+	}
+	s := &Scheduler{api: api, cfg: cfg, now: time.Now}
+	for _, r := range cfg.Rules {
+		parsed := &rule{Rule: r}
+		sel := r.Selector
+		if sel.Kind != KindClickHouse && sel.Kind != KindKafka {
+			return nil, fmt.Errorf("rule %q: unknown cluster kind %q", r.Name, sel.Kind)
+		}
+		if len(sel.ClusterIDs) == 0 && sel.ProjectID == "" {
+			return nil, fmt.Errorf("rule %q: ClusterIDs or ProjectID required", r.Name)
+		}
+		for _, pattern := range sel.Names {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("rule %q: name pattern %q: %w", r.Name, pattern, err)
+			}
+		}
+		var err error
+		if r.Stop != "" {
+			if parsed.stop, err = ParseSchedule(r.Stop, cfg.Location); err != nil {
+				return nil, sdkerrors.WithMessagef(err, "rule %q", r.Name)
+			}
+		}
+		if r.Start != "" {
+			if parsed.start, err = ParseSchedule(r.Start, cfg.Location); err != nil {
+				return nil, sdkerrors.WithMessagef(err, "rule %q", r.Name)
+			}
+		}
+		if parsed.stop == nil && parsed.start == nil {
+			return nil, fmt.Errorf("rule %q: Stop or Start schedule required", r.Name)
+		}
+		s.rules = append(s.rules, parsed)
+	}
+	return s, nil
+}
+
+// Next returns the first time after t any rule fires at. Returns zero time if no rule ever fires.
+func (s *Scheduler) Next(t time.Time) time.Time {
+	var next time.Time
+	for _, r := range s.rules {
+		for _, schedule := range []*Schedule{r.stop, r.start} {
+			if schedule == nil {
+				continue
+			}
+			if n := schedule.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+				next = n
+			}
+		}
+	}
+	return next
+}
+
+// Run performs actions of rules when they are due until ctx is done, passing the report of every run
+// to onReport. Returns ctx error. Runs are sequential: occurrences missed while the previous run waited
+// for operations, or while the process was suspended, are collapsed into a single run, in which every
+// rule performs only the action of its latest missed occurrence.
+func (s *Scheduler) Run(ctx context.Context, onReport func(*Report)) error {
+	last := s.now()
+	for {
+		next := s.Next(last)
+		if next.IsZero() {
+			return errors.New("scheduler: no scheduled actions")
+		}
+		// Timer fires at once if next has passed already.
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		until := s.now().Truncate(time.Minute)
+		if until.Before(next) {
+			until = next
+		}
+		actions := map[*rule]Action{}
+		var at time.Time
+		for _, r := range s.rules {
+			if action, t := r.latest(last, until); !t.IsZero() {
+				actions[r] = action
+				if t.After(at) {
+					at = t
+				}
+			}
+		}
+		report := s.run(ctx, at, actions)
+		if onReport != nil {
+			onReport(report)
+		}
+		last = until
+	}
+}
+
+// latest returns the action of the latest occurrence of r in (after, until] and its time.
+// Stop takes precedence over start at the same minute, as in RunAt.
+func (r *rule) latest(after, until time.Time) (Action, time.Time) {
+	var action Action
+	var at time.Time
+	for _, sa := range []struct {
+		schedule *Schedule
+		action   Action
+	}{{r.stop, ActionStop}, {r.start, ActionStart}} {
+		if sa.schedule == nil {
+			continue
+		}
+		var last time.Time
+		for t := sa.schedule.Next(after); !t.IsZero() && !t.After(until); t = sa.schedule.Next(t) {
+			last = t
+		}
+		if !last.IsZero() && (at.IsZero() || last.After(at)) {
+			action, at = sa.action, last
+		}
+	}
+	return action, at
+}
+
+// RunAt performs actions of rules that fire at minute of t and waits for their operations.
+// Clusters already in the target state, busy with another operation, or close to planned maintenance
+// are skipped.
+func (s *Scheduler) RunAt(ctx context.Context, t time.Time) *Report {
+	actions := map[*rule]Action{}
+	for _, r := range s.rules {
+		switch {
+		case r.stop != nil && r.stop.Matches(t):
+			actions[r] = ActionStop
+		case r.start != nil && r.start.Matches(t):
+			actions[r] = ActionStart
+		}
+	}
+	return s.run(ctx, t, actions)
+}
+
+// run performs actions of rules at t and waits for their operations.
+func (s *Scheduler) run(ctx context.Context, t time.Time, actions map[*rule]Action) *Report {
+	report := &Report{Time: t}
+	type task struct {
+		result  *Result
+		cluster *cluster
+	}
+	var tasks []task
+	for _, r := range s.rules {
+		action, ok := actions[r]
+		if !ok {
+			continue
+		}
+		clusters, err := s.clusters(ctx, r.Selector)
+		if err != nil {
+			report.Results = append(report.Results, &Result{
+				Rule: r.Name, Kind: r.Selector.Kind, Action: action, Outcome: OutcomeFailed, Err: err,
+			})
+			continue
+		}
+		for _, c := range clusters {
+			res := &Result{Rule: r.Name, Kind: c.kind, ClusterID: c.id, ClusterName: c.name, Action: action}
+			report.Results = append(report.Results, res)
+			tasks = append(tasks, task{result: res, cluster: c})
+		}
+	}
+
+	// Rules may select the same cluster, such actions are performed one after another.
+	var locks sync.Map
+	var g errgroup.Group
+	g.SetLimit(s.cfg.Concurrency)
+	for _, tk := range tasks {
+		tk := tk
+		g.Go(func() error {
+			mu, _ := locks.LoadOrStore(tk.cluster.id, &sync.Mutex{})
+			mu.(*sync.Mutex).Lock()
+			defer mu.(*sync.Mutex).Unlock()
+			s.perform(ctx, t, tk.result)
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return report
+}
+
+// perform gets the current state of the cluster and performs action if it's applicable.
+func (s *Scheduler) perform(ctx context.Context, t time.Time, res *Result) {
+	c, err := s.cluster(ctx, res.Kind, res.ClusterID)
+	if err == nil {
+		res.Reason = s.skipReason(c, res.Action, t)
+		if res.Reason != "" {
+			res.Outcome = OutcomeSkipped
+			return
+		}
+		err = s.do(ctx, c, res.Action)
+	}
+	if err != nil {
+		res.Outcome, res.Err = OutcomeFailed, err
+		return
+	}
+	res.Outcome = OutcomeDone
+}
+
+func (s *Scheduler) skipReason(c *cluster, action Action, t time.Time) string {
+	if planned := c.planned.GetScheduledMaintenanceTime(); planned != nil {
+		at := planned.AsTime()
+		if at.After(t.Add(-s.cfg.MaintenanceMargin)) && at.Before(t.Add(s.cfg.MaintenanceMargin)) {
+			return fmt.Sprintf("maintenance planned at %s", at.Format(time.RFC3339))
+		}
+	}
+	switch {
+	case action == ActionStop && c.status == dcv1.ClusterStatus_CLUSTER_STATUS_STOPPED,
+		action == ActionStart && c.status == dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE:
+		return "already in target state"
+	case c.status == dcv1.ClusterStatus_CLUSTER_STATUS_UPDATING:
+		return "cluster is in maintenance or being updated"
+	case action == ActionStop && c.status != dcv1.ClusterStatus_CLUSTER_STATUS_ALIVE,
+		action == ActionStart && c.status != dcv1.ClusterStatus_CLUSTER_STATUS_STOPPED:
+		return fmt.Sprintf("cluster status is %s", c.status)
+	}
+	return ""
+}
+
+func (s *Scheduler) do(ctx context.Context, c *cluster, action Action) error {
+	var op *dcv1.Operation
+	var err error
+	switch {
+	case c.kind == KindClickHouse && action == ActionStop:
+		op, err = s.api.ClickHouse().Cluster().Stop(ctx, &chv1.StopClusterRequest{ClusterId: c.id})
+	case c.kind == KindClickHouse && action == ActionStart:
+		op, err = s.api.ClickHouse().Cluster().Start(ctx, &chv1.StartClusterRequest{ClusterId: c.id})
+	case c.kind == KindKafka && action == ActionStop:
+		op, err = s.api.Kafka().Cluster().Stop(ctx, &kfv1.StopClusterRequest{ClusterId: c.id})
+	default:
+		op, err = s.api.Kafka().Cluster().Start(ctx, &kfv1.StartClusterRequest{ClusterId: c.id})
+	}
+	wrapped, err := s.api.WrapOperation(op, err)
+	if err != nil {
+		return sdkerrors.WithMessagef(err, "cluster %s %s failed", c.id, action)
+	}
+	if err := wrapped.Wait(ctx); err != nil {
+		return sdkerrors.WithMessagef(err, "cluster %s %s failed", c.id, action)
+	}
+	return nil
+}
+
+// cluster is a state of ClickHouse or Kafka cluster the scheduler depends on.
+type cluster struct {
+	kind    Kind
+	id      string
+	name    string
+	status  dcv1.ClusterStatus
+	planned *dcv1.MaintenanceOperation
+}
+
+func (s *Scheduler) cluster(ctx context.Context, kind Kind, id string) (*cluster, error) {
+	if kind == KindClickHouse {
+		c, err := s.api.ClickHouse().Cluster().Get(ctx, &chv1.GetClusterRequest{ClusterId: id})
+		if err != nil {
+			return nil, sdkerrors.WithMessagef(err, "cluster %s get failed", id)
+		}
+		return &cluster{kind: kind, id: c.Id, name: c.Name, status: c.Status, planned: c.MaintenanceOperation}, nil
+	}
+	c, err := s.api.Kafka().Cluster().Get(ctx, &kfv1.GetClusterRequest{ClusterId: id})
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "cluster %s get failed", id)
+	}
+	return &cluster{kind: kind, id: c.Id, name: c.Name, status: c.Status, planned: c.PlannedOperation}, nil
+}
+
+// clusters returns clusters of selector without duplicates.
+func (s *Scheduler) clusters(ctx context.Context, sel Selector) ([]*cluster, error) {
+	var result []*cluster
+	seen := map[string]bool{}
+	add := func(c *cluster) {
+		if !seen[c.id] {
+			seen[c.id] = true
+			result = append(result, c)
+		}
+	}
+	for _, id := range sel.ClusterIDs {
+		c, err := s.cluster(ctx, sel.Kind, id)
+		if err != nil {
+			return nil, err
+		}
+		add(c)
+	}
+	if sel.ProjectID == "" {
+		return result, nil
+	}
+	listed, err := s.list(ctx, sel.Kind, sel.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range listed {
+		if matchName(sel.Names, c.name) {
+			add(c)
+		}
+	}
+	return result, nil
+}
+
+func (s *Scheduler) list(ctx context.Context, kind Kind, projectID string) ([]*cluster, error) {
+	listed, err := maintenance.ListKind(ctx, s.api, kind, projectID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*cluster, 0, len(listed))
+	for _, m := range listed {
+		result = append(result, &cluster{kind: kind, id: m.ClusterID, name: m.ClusterName, status: m.Status, planned: m.Planned})
+	}
+	return result, nil
+}
+
+func matchName(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
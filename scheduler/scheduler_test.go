@@ -0,0 +1,258 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	kfv1 "github.com/doublecloud/go-genproto/doublecloud/kafka/v1"
+	dcv1 "github.com/doublecloud/go-genproto/doublecloud/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/dctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func createClickHouse(t *testing.T, api dcsdk.API, name string) string {
+	ctx := context.Background()
+	op, err := api.WrapOperation(api.ClickHouse().Cluster().Create(ctx, &chv1.CreateClusterRequest{ProjectId: "p1", Name: name}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	return op.ResourceId()
+}
+
+func createKafka(t *testing.T, api dcsdk.API, name string) string {
+	ctx := context.Background()
+	op, err := api.WrapOperation(api.Kafka().Cluster().Create(ctx, &kfv1.CreateClusterRequest{ProjectId: "p1", Name: name}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	return op.ResourceId()
+}
+
+func outcomes(r *Report) map[string]Outcome {
+	result := map[string]Outcome{}
+	for _, res := range r.Results {
+		result[res.ClusterName] = res.Outcome
+	}
+	return result
+}
+
+func TestScheduler_RunAt(t *testing.T) {
+	ctx := context.Background()
+	srv := dctest.NewServer(dctest.Config{})
+	defer srv.Close()
+	sdk, err := srv.SDK(ctx)
+	require.NoError(t, err)
+	api := sdk.API()
+
+	createClickHouse(t, api, "dev-a")
+	stopped := createClickHouse(t, api, "dev-b")
+	op, err := api.WrapOperation(api.ClickHouse().Cluster().Stop(ctx, &chv1.StopClusterRequest{ClusterId: stopped}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	updating := createClickHouse(t, api, "dev-c")
+	require.NoError(t, srv.UpdateClickHouseCluster(updating, func(c *chv1.Cluster) {
+		c.Status = dcv1.ClusterStatus_CLUSTER_STATUS_UPDATING
+	}))
+	createClickHouse(t, api, "prod")
+	maintained := createClickHouse(t, api, "dev-d")
+
+	evening := time.Date(2024, 6, 12, 20, 0, 0, 0, time.UTC)
+	kafkaMaintained := createKafka(t, api, "kafka-a")
+	require.NoError(t, srv.UpdateKafkaCluster(kafkaMaintained, func(c *kfv1.Cluster) {
+		c.PlannedOperation = &dcv1.MaintenanceOperation{ScheduledMaintenanceTime: timestamppb.New(evening.Add(30 * time.Minute))}
+	}))
+	kafka := createKafka(t, api, "kafka-b")
+	require.NoError(t, srv.UpdateClickHouseCluster(maintained, func(c *chv1.Cluster) {
+		c.MaintenanceOperation = &dcv1.MaintenanceOperation{ScheduledMaintenanceTime: timestamppb.New(evening.Add(-30 * time.Minute))}
+	}))
+
+	s, err := New(api, Config{Rules: []Rule{
+		{
+			Name:     "dev",
+			Selector: Selector{Kind: KindClickHouse, ProjectID: "p1", Names: []string{"dev-*"}},
+			Stop:     "0 20 * * *",
+			Start:    "0 8 * * *",
+		},
+		{
+			Name:     "kafka",
+			Selector: Selector{Kind: KindKafka, ClusterIDs: []string{kafkaMaintained, kafka}},
+			Stop:     "0 20 * * *",
+		},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, evening, s.Next(evening.Add(-time.Hour)))
+
+	report := s.RunAt(ctx, evening)
+	require.NoError(t, report.Err())
+	assert.Equal(t, map[string]Outcome{
+		"dev-a":   OutcomeDone,
+		"dev-b":   OutcomeSkipped,
+		"dev-c":   OutcomeSkipped,
+		"dev-d":   OutcomeSkipped,
+		"kafka-a": OutcomeSkipped,
+		"kafka-b": OutcomeDone,
+	}, outcomes(report))
+	assert.Contains(t, report.String(), "dev: stop clickhouse")
+	assert.Contains(t, report.String(), "maintenance planned at")
+	kf, err := api.Kafka().Cluster().Get(ctx, &kfv1.GetClusterRequest{ClusterId: kafka})
+	require.NoError(t, err)
+	assert.Equal(t, dcv1.ClusterStatus_CLUSTER_STATUS_STOPPED, kf.Status)
+
+	assert.Empty(t, s.RunAt(ctx, evening.Add(time.Minute)).Results)
+
+	report = s.RunAt(ctx, evening.Add(12*time.Hour))
+	require.NoError(t, report.Err())
+	assert.Equal(t, map[string]Outcome{
+		"dev-a": OutcomeDone,
+		"dev-b": OutcomeDone,
+		"dev-c": OutcomeSkipped,
+		"dev-d": OutcomeSkipped,
+	}, outcomes(report))
+
+	srv.InjectFault(dctest.Fault{Method: chv1.ClusterService_Stop_FullMethodName, Code: codes.Unavailable})
+	report = s.RunAt(ctx, evening.AddDate(0, 0, 1))
+	assert.Equal(t, OutcomeFailed, outcomes(report)["dev-a"])
+	assert.Error(t, report.Err())
+}
+
+func TestNew_Validates(t *testing.T) {
+	for name, rule := range map[string]Rule{
+		"kind":     {Selector: Selector{ProjectID: "p1"}, Stop: "* * * * *"},
+		"selector": {Selector: Selector{Kind: KindKafka}, Stop: "* * * * *"},
+		"pattern":  {Selector: Selector{Kind: KindKafka, ProjectID: "p1", Names: []string{"["}}, Stop: "* * * * *"},
+		"schedule": {Selector: Selector{Kind: KindKafka, ProjectID: "p1"}, Stop: "* * *"},
+		"empty":    {Selector: Selector{Kind: KindKafka, ProjectID: "p1"}},
+	} {
+		_, err := New(nil, Config{Rules: []Rule{rule}})
+		assert.Error(t, err, name)
+	}
+}
+
+func TestScheduler_RunStops(t *testing.T) {
+	s, err := New(nil, Config{Rules: []Rule{{
+		Selector: Selector{Kind: KindKafka, ProjectID: "p1"},
+		Stop:     "0 0 1 1 *",
+	}}})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, s.Run(ctx, nil), context.DeadlineExceeded)
+}
+
+func TestScheduler_RunMissed(t *testing.T) {
+	evening := time.Date(2024, 6, 12, 20, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	now := evening.Add(-10 * time.Millisecond)
+	// Stop operation outlasts the next fire time.
+	srv := dctest.NewServer(dctest.Config{ServerOptions: []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if info.FullMethod == chv1.ClusterService_Stop_FullMethodName {
+				mu.Lock()
+				now = now.Add(7 * time.Minute)
+				mu.Unlock()
+			}
+			return handler(ctx, req)
+		}),
+	}})
+	defer srv.Close()
+	sdk, err := srv.SDK(context.Background())
+	require.NoError(t, err)
+	api := sdk.API()
+	createClickHouse(t, api, "dev-a")
+	createClickHouse(t, api, "dev-b")
+
+	s, err := New(api, Config{Rules: []Rule{
+		{Name: "a", Selector: Selector{Kind: KindClickHouse, ProjectID: "p1", Names: []string{"dev-a"}}, Stop: "0 20 * * *"},
+		{Name: "b", Selector: Selector{Kind: KindClickHouse, ProjectID: "p1", Names: []string{"dev-b"}}, Stop: "5 20 * * *"},
+	}})
+	require.NoError(t, err)
+	s.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var reports []*Report
+	err = s.Run(ctx, func(r *Report) {
+		reports = append(reports, r)
+		if len(reports) == 2 {
+			cancel()
+		}
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, reports, 2)
+	assert.Equal(t, evening, reports[0].Time)
+	assert.Equal(t, map[string]Outcome{"dev-a": OutcomeDone}, outcomes(reports[0]))
+	assert.Equal(t, evening.Add(5*time.Minute), reports[1].Time)
+	assert.Equal(t, map[string]Outcome{"dev-b": OutcomeDone}, outcomes(reports[1]))
+}
+
+func TestScheduler_RunCollapsesMissed(t *testing.T) {
+	evening := time.Date(2024, 6, 12, 20, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	now := evening.Add(-10 * time.Millisecond)
+	stalled := false
+	// The first stop stalls the process for 36.5 hours: missed are 12:00 stop of dev-b,
+	// 08:00 start and 20:00 stop of dev-a on the next day, and 08:00 start of dev-a on the day after.
+	srv := dctest.NewServer(dctest.Config{ServerOptions: []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if info.FullMethod == chv1.ClusterService_Stop_FullMethodName {
+				mu.Lock()
+				if !stalled {
+					now = now.Add(36*time.Hour + 30*time.Minute)
+					stalled = true
+				}
+				mu.Unlock()
+			}
+			return handler(ctx, req)
+		}),
+	}})
+	defer srv.Close()
+	sdk, err := srv.SDK(context.Background())
+	require.NoError(t, err)
+	api := sdk.API()
+	createClickHouse(t, api, "dev-a")
+	createClickHouse(t, api, "dev-b")
+
+	s, err := New(api, Config{Rules: []Rule{
+		{Name: "a", Selector: Selector{Kind: KindClickHouse, ProjectID: "p1", Names: []string{"dev-a"}}, Stop: "0 20 * * *", Start: "0 8 * * *"},
+		{Name: "b", Selector: Selector{Kind: KindClickHouse, ProjectID: "p1", Names: []string{"dev-b"}}, Stop: "0 12 * * *"},
+	}})
+	require.NoError(t, err)
+	s.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var reports []*Report
+	err = s.Run(ctx, func(r *Report) {
+		reports = append(reports, r)
+		if len(reports) == 2 {
+			cancel()
+		}
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, reports, 2)
+	assert.Equal(t, evening, reports[0].Time)
+	assert.Equal(t, map[string]Outcome{"dev-a": OutcomeDone}, outcomes(reports[0]))
+
+	assert.Equal(t, time.Date(2024, 6, 14, 8, 0, 0, 0, time.UTC), reports[1].Time)
+	require.Len(t, reports[1].Results, 2)
+	assert.Equal(t, "dev-a", reports[1].Results[0].ClusterName)
+	assert.Equal(t, ActionStart, reports[1].Results[0].Action)
+	assert.Equal(t, OutcomeDone, reports[1].Results[0].Outcome)
+	assert.Equal(t, "dev-b", reports[1].Results[1].ClusterName)
+	assert.Equal(t, ActionStop, reports[1].Results[1].Action)
+	assert.Equal(t, OutcomeDone, reports[1].Results[1].Outcome)
+}
@@ -3,14 +3,9 @@ package dcsdk
 import (
 	"context"
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/doublecloud/go-sdk/gen/organization"
-	"io/ioutil"
-	"net"
-	"net/http"
-	"net/http/httputil"
 	"os"
 	"sort"
 	"strings"
@@ -33,8 +28,6 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/grpclog"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Endpoint string
@@ -64,19 +57,42 @@ type Config struct {
 	// DialContextTimeout time.Duration
 	// TLSConfig is optional tls.Config that one can use in order to tune TLS options.
 	TLSConfig *tls.Config
+	// CAFile is a path to PEM encoded CA bundle that is used to verify server certificates
+	// instead of system roots. DOUBLE_CLOUD_CA_FILE env is used when empty.
+	CAFile string
+	// CertFile and KeyFile are paths to PEM encoded client certificate and private key used for mutual TLS.
+	// DOUBLE_CLOUD_CLIENT_CERT_FILE and DOUBLE_CLOUD_CLIENT_KEY_FILE envs are used when empty.
+	CertFile string
+	KeyFile  string
+	// TLSServerName overrides server name used to verify server certificates.
+	// DOUBLE_CLOUD_TLS_SERVER_NAME env is used when empty.
+	TLSServerName string
 
 	// Endpoint is an API endpoint of DoubleCloud against which the SDK is used.
 	// Most users won't need to explicitly set it.
 	Endpoint         string
 	OverrideEndpoint bool
 	Plaintext        bool
+
+	// ApplicationName identifies the application that uses the SDK, e.g. "my-tool/1.2.0".
+	// It is appended to User-Agent of both API and token endpoint requests.
+	ApplicationName string
+
+	// TokenExchanger is used to exchange ExchangeableCredentials and SubjectTokenCredentials for IAM tokens.
+	// By default HTTPTokenExchanger with default timeouts and retries is used,
+	// TLS options of the Config are applied to it as well.
+	TokenExchanger TokenExchanger
+
+	// OnTokenIssued is called every time the SDK issues or refreshes IAM token, e.g. for auditing.
+	OnTokenIssued TokenIssuedHook
 }
 
 // SDK is a DoubleCloud SDK
 type SDK struct {
-	conf      Config
-	cc        grpcclient.ConnContext
-	endpoints struct {
+	conf            Config
+	cc              grpcclient.ConnContext
+	tokenMiddleware *IamTokenMiddleware
+	endpoints       struct {
 		initDone bool
 		mu       sync.Mutex
 		ep       map[Endpoint]*APIEndpoint
@@ -97,10 +113,20 @@ func Build(ctx context.Context, conf Config, customOpts ...grpc.DialOption) (*SD
 	if conf.Endpoint == "" {
 		conf.Endpoint = defaultEndpoint
 	}
+	tlsConfig, err := conf.tlsConfig()
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "TLS config build failed")
+	}
+	if conf.TokenExchanger == nil {
+		conf.TokenExchanger = &HTTPTokenExchanger{
+			TLSConfig: tlsConfig,
+			UserAgent: userAgent(conf.ApplicationName),
+		}
+	}
 	const DefaultTimeout = 20 * time.Second
 
 	switch creds := conf.Credentials.(type) {
-	case ExchangeableCredentials, NonExchangeableCredentials:
+	case ExchangeableCredentials, NonExchangeableCredentials, SubjectTokenCredentials:
 	default:
 		return nil, fmt.Errorf("unsupported credentials type %T", creds)
 	}
@@ -108,17 +134,25 @@ func Build(ctx context.Context, conf Config, customOpts ...grpc.DialOption) (*SD
 		cc:   nil, // Later
 		conf: conf,
 	}
-	tokenMiddleware := NewIAMTokenMiddleware(sdk, now)
+	middlewareOpts := []IamTokenMiddlewareOption{WithTokenIssuedHook(conf.OnTokenIssued)}
+	switch conf.Credentials.(type) {
+	case NoCredentials, *NoCredentials:
+		// Calls are sent without authorization header, e.g. to a local fake API in tests,
+		// unless they are made WithCredentials.
+		middlewareOpts = append(middlewareOpts, withUnauthenticatedMainSubject())
+	}
+	tokenMiddleware := NewIAMTokenMiddleware(sdk, now, middlewareOpts...)
+	sdk.tokenMiddleware = tokenMiddleware
 	var dialOpts []grpc.DialOption
 	dialOpts = append(dialOpts,
 		grpc.WithChainUnaryInterceptor(tokenMiddleware.InterceptUnary),
 		grpc.WithChainStreamInterceptor(tokenMiddleware.InterceptStream),
 	)
+	dialOpts = append(dialOpts, grpc.WithUserAgent(userAgent(conf.ApplicationName)))
 
 	if conf.Plaintext {
 		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	} else {
-		tlsConfig := conf.TLSConfig
 		if tlsConfig == nil {
 			tlsConfig = &tls.Config{}
 		}
@@ -266,81 +300,25 @@ func tokenURL() string {
 	return "https://auth.double.cloud/oauth/token"
 }
 
-func exchangeJWT2IAM(ctx context.Context, request *iamkey.CreateIamTokenRequest) (*iamkey.CreateIamTokenResponse, error) {
-	client := http.Client{
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   time.Second, // One second should be enough for localhost connection.
-				KeepAlive: -1,          // No keep alive. Near token per hour requested.
-			}).DialContext,
-		},
-	}
-	data := fmt.Sprintf("grant_type=urn:ietf:params:oauth:grant-type:jwt-bearer&assertion=%v", request.GetJwt())
-
-	req, err := http.NewRequest("POST", tokenURL(), strings.NewReader(data))
-	if err != nil {
-		return nil, sdkerrors.WithMessage(err, "request make failed")
-	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	// TODO: define user-agent version
-	req.Header.Set("User-Agent", "doublecloud-go-sdk")
-	reqDump, _ := httputil.DumpRequestOut(req, false)
-	grpclog.Infof("Going to request SA token:\n%s", reqDump)
-	resp, err := client.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, sdkerrors.WithMessage(err, "failed to exchange JWT")
-	}
-	defer resp.Body.Close()
-	respDump, _ := httputil.DumpResponse(resp, false)
-	grpclog.Infof("response (without body, because contains sensitive token):\n%s", respDump)
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("%s.\n"+
-			"Is this IAM running using Service Account? That is, Instance.service_account_id should not be empty.",
-			resp.Status)
-	}
-	body, err := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode != http.StatusOK {
-		if err != nil {
-			body = []byte(fmt.Sprintf("Failed response body read failed: %s", err.Error()))
-		}
-		grpclog.Errorf("IAM service token get failed: %s. Body:\n%s", resp.Status, body)
-		return nil, fmt.Errorf("%s", resp.Status)
-	}
-	if err != nil {
-		return nil, fmt.Errorf("reponse read failed: %s", err)
-	}
-
-	var tokenResponse struct {
-		AccessToken string `json:"access_token"`
-		ExpiresIn   int64  `json:"expires_in"`
-		TokenType   string `json:"token_type"`
-	}
-
-	err = json.Unmarshal(body, &tokenResponse)
-	if err != nil {
-		grpclog.Errorf("Failed to unmarshal SA token response body.\nError: %s\nBody:\n%s", err, body)
-		return nil, sdkerrors.WithMessage(err, "body unmarshal failed")
-	}
-	expiresAt := timestamppb.Now()
-	expiresAt.Seconds += tokenResponse.ExpiresIn - 1
-	expiresAt.Nanos = 0 // Truncate is for readability.
-	return &iamkey.CreateIamTokenResponse{
-		IamToken:  tokenResponse.AccessToken,
-		ExpiresAt: expiresAt,
-	}, nil
+func (sdk *SDK) CreateIAMToken(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
+	return sdk.CreateIAMTokenForCredentials(ctx, sdk.conf.Credentials)
 }
 
-func (sdk *SDK) CreateIAMToken(ctx context.Context) (*iamkey.CreateIamTokenResponse, error) {
-	creds := sdk.conf.Credentials
+// CreateIAMTokenForCredentials issues IAM token for creds using the SDK TokenExchanger.
+func (sdk *SDK) CreateIAMTokenForCredentials(ctx context.Context, creds Credentials) (*iamkey.CreateIamTokenResponse, error) {
 	switch creds := creds.(type) {
+	case SubjectTokenCredentials:
+		req, err := creds.SubjectTokenRequest(ctx)
+		if err != nil {
+			return nil, sdkerrors.WithMessage(err, "subject token request build failed")
+		}
+		return sdk.conf.TokenExchanger.ExchangeSubjectToken(ctx, req)
 	case ExchangeableCredentials:
 		req, err := creds.IAMTokenRequest()
 		if err != nil {
 			return nil, sdkerrors.WithMessage(err, "IAM token request build failed")
 		}
-		return exchangeJWT2IAM(ctx, req)
+		return sdk.conf.TokenExchanger.Exchange(ctx, req)
 	case NonExchangeableCredentials:
 		return creds.IAMToken(ctx)
 	default:
@@ -348,6 +326,24 @@ func (sdk *SDK) CreateIAMToken(ctx context.Context) (*iamkey.CreateIamTokenRespo
 	}
 }
 
+// TokenInfo describes IAM token the SDK uses for calls with opts, e.g. WithCredentials.
+// The token is issued if there is no valid cached one.
+func (sdk *SDK) TokenInfo(ctx context.Context, opts ...grpc.CallOption) (*TokenInfo, error) {
+	return sdk.tokenMiddleware.TokenInfo(ctx, opts...)
+}
+
+func (sdk *SDK) credentials() Credentials {
+	return sdk.conf.Credentials
+}
+
+// InvalidateIAMToken implements TokenInvalidator by passing the rejected token to credentials that cache tokens.
+func (sdk *SDK) InvalidateIAMToken(ctx context.Context, iamToken string) error {
+	if invalidator, ok := sdk.conf.Credentials.(TokenInvalidator); ok {
+		return invalidator.InvalidateIAMToken(ctx, iamToken)
+	}
+	return nil
+}
+
 func (sdk *SDK) CreateIAMTokenForServiceAccount(ctx context.Context, serviceAccountID string) (*iamkey.CreateIamTokenResponse, error) {
 	// Later
 	return nil, nil
@@ -0,0 +1,45 @@
+package dcsdk_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	chv1 "github.com/doublecloud/go-genproto/doublecloud/clickhouse/v1"
+	dcsdk "github.com/doublecloud/go-sdk"
+	"github.com/doublecloud/go-sdk/dctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestBuild_NoCredentialsWithCredentials(t *testing.T) {
+	ctx := context.Background()
+	var mu sync.Mutex
+	var auth [][]string
+	srv := dctest.NewServer(dctest.Config{ServerOptions: []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			mu.Lock()
+			auth = append(auth, md.Get("authorization"))
+			mu.Unlock()
+			return handler(ctx, req)
+		}),
+	}})
+	defer srv.Close()
+	sdk, err := srv.SDK(ctx)
+	require.NoError(t, err)
+	clusters := sdk.ClickHouse().Cluster()
+
+	_, err = clusters.List(ctx, &chv1.ListClustersRequest{})
+	require.NoError(t, err)
+	_, err = clusters.List(ctx, &chv1.ListClustersRequest{}, dcsdk.WithCredentials(dcsdk.NewIAMTokenCredentials("tenant-token")))
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, auth, 2)
+	assert.Empty(t, auth[0], "SDK credentials are not sent")
+	assert.Equal(t, []string{"Bearer tenant-token"}, auth[1])
+}
@@ -0,0 +1,71 @@
+package dcsdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+)
+
+const (
+	CAFileEnv        = "DOUBLE_CLOUD_CA_FILE"
+	CertFileEnv      = "DOUBLE_CLOUD_CLIENT_CERT_FILE"
+	KeyFileEnv       = "DOUBLE_CLOUD_CLIENT_KEY_FILE"
+	TLSServerNameEnv = "DOUBLE_CLOUD_TLS_SERVER_NAME"
+)
+
+// tlsConfig builds tls.Config from Config.TLSConfig, file options and environment.
+// Options set in Config take precedence over environment variables.
+// Returns nil if neither TLSConfig nor any of the TLS options are set.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	caFile := valueOrEnv(c.CAFile, CAFileEnv)
+	certFile := valueOrEnv(c.CertFile, CertFileEnv)
+	keyFile := valueOrEnv(c.KeyFile, KeyFileEnv)
+	serverName := valueOrEnv(c.TLSServerName, TLSServerNameEnv)
+
+	if caFile == "" && certFile == "" && keyFile == "" && serverName == "" {
+		return c.TLSConfig, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if c.TLSConfig != nil {
+		tlsConfig = c.TLSConfig.Clone()
+	}
+	if caFile != "" {
+		pemData, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, sdkerrors.WithMessagef(err, "CA bundle '%s' read fail", caFile)
+		}
+		pool := tlsConfig.RootCAs
+		if pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pemData) {
+			return nil, fmt.Errorf("CA bundle '%s' contains no PEM encoded certificates", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("both client certificate and key files are required for mutual TLS, got cert '%s' and key '%s'", certFile, keyFile)
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, sdkerrors.WithMessage(err, "client certificate load fail")
+		}
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+	}
+	if serverName != "" {
+		tlsConfig.ServerName = serverName
+	}
+	return tlsConfig, nil
+}
+
+func valueOrEnv(value, env string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(env)
+}
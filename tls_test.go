@@ -0,0 +1,49 @@
+package dcsdk
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_TLSConfigFromCAFile(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"access_token":"iam-token","expires_in":3600}`))
+	}))
+	defer srv.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0600))
+
+	_, err := (&HTTPTokenExchanger{TokenURL: srv.URL, MaxRetries: -1}).Exchange(context.Background(), jwtRequest("jwt"))
+	require.Error(t, err, "self-signed certificate must not be trusted by default")
+
+	conf := Config{CAFile: caFile, TLSServerName: "example.com"}
+	tlsConfig, err := conf.tlsConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", tlsConfig.ServerName)
+
+	resp, err := (&HTTPTokenExchanger{TokenURL: srv.URL, TLSConfig: tlsConfig}).Exchange(context.Background(), jwtRequest("jwt"))
+	require.NoError(t, err)
+	assert.Equal(t, "iam-token", resp.IamToken)
+}
+
+func TestConfig_TLSConfigFromEnv(t *testing.T) {
+	t.Setenv(CertFileEnv, "cert.pem")
+	_, err := (&Config{}).tlsConfig()
+	require.Error(t, err, "client certificate without key must be rejected")
+
+	t.Setenv(CertFileEnv, "")
+	t.Setenv(TLSServerNameEnv, "api.local")
+	tlsConfig, err := (&Config{}).tlsConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "api.local", tlsConfig.ServerName)
+}
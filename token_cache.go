@@ -0,0 +1,217 @@
+package dcsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/doublecloud/go-sdk/pkg/filelock"
+	"github.com/doublecloud/go-sdk/pkg/keyring"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+// TokenCache stores IAM tokens between SDK calls and, depending on implementation, between processes.
+// Implementations must be safe for concurrent use.
+type TokenCache interface {
+	// Get returns the token cached for key, or nil if there is no such token.
+	Get(ctx context.Context, key string) (*iamkey.CreateIamTokenResponse, error)
+	// Set caches the token for key, replacing the previous one.
+	Set(ctx context.Context, key string, token *iamkey.CreateIamTokenResponse) error
+	// Delete removes the token cached for key. Deleting a missing token is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// TokenCacheLocker is implemented by TokenCache that is shared between processes.
+// Credentials hold the lock while refreshing the token, so only one process
+// performs the refresh and others pick up its result.
+type TokenCacheLocker interface {
+	Lock(ctx context.Context, key string) (unlock func() error, err error)
+}
+
+// FileTokenCache stores tokens as JSON files in a directory, one file per key.
+// Directory is created with 0700 and files with 0600 permissions, files are replaced atomically.
+type FileTokenCache struct {
+	dir string
+}
+
+var _ TokenCache = &FileTokenCache{}
+var _ TokenCacheLocker = &FileTokenCache{}
+
+func NewFileTokenCache(dir string) *FileTokenCache {
+	return &FileTokenCache{dir: dir}
+}
+
+func (c *FileTokenCache) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || strings.HasPrefix(key, ".") {
+		return "", fmt.Errorf("invalid token cache key %q", key)
+	}
+	return filepath.Join(c.dir, key+".json"), nil
+}
+
+func (c *FileTokenCache) Get(ctx context.Context, key string) (*iamkey.CreateIamTokenResponse, error) {
+	path, err := c.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "token cache file '%s' read fail", path)
+	}
+	token := &iamkey.CreateIamTokenResponse{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, sdkerrors.WithMessagef(err, "token cache file '%s' unmarshal fail", path)
+	}
+	return token, nil
+}
+
+func (c *FileTokenCache) Set(ctx context.Context, key string, token *iamkey.CreateIamTokenResponse) error {
+	path, err := c.path(key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return sdkerrors.WithMessage(err, "token marshal fail")
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return sdkerrors.WithMessagef(err, "token cache dir '%s' create fail", c.dir)
+	}
+	// CreateTemp creates file with 0600 permissions.
+	tmp, err := os.CreateTemp(c.dir, "."+key+".*.tmp")
+	if err != nil {
+		return sdkerrors.WithMessagef(err, "token cache dir '%s' write fail", c.dir)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return sdkerrors.WithMessagef(err, "file '%s' write fail", tmp.Name())
+	}
+	if err := tmp.Close(); err != nil {
+		return sdkerrors.WithMessagef(err, "file '%s' write fail", tmp.Name())
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return sdkerrors.WithMessagef(err, "file '%s' write fail", path)
+	}
+	return nil
+}
+
+func (c *FileTokenCache) Delete(ctx context.Context, key string) error {
+	path, err := c.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return sdkerrors.WithMessagef(err, "token cache file '%s' remove fail", path)
+	}
+	return nil
+}
+
+// Lock implements TokenCacheLocker with an advisory lock on a separate lock file.
+func (c *FileTokenCache) Lock(ctx context.Context, key string) (func() error, error) {
+	path, err := c.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return nil, sdkerrors.WithMessagef(err, "token cache dir '%s' create fail", c.dir)
+	}
+	unlock, err := filelock.Lock(ctx, path+".lock")
+	if err != nil {
+		return nil, sdkerrors.WithMessagef(err, "token cache file '%s' lock fail", path)
+	}
+	return unlock, nil
+}
+
+// MemoryTokenCache stores tokens in process memory only.
+type MemoryTokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]*iamkey.CreateIamTokenResponse
+}
+
+var _ TokenCache = &MemoryTokenCache{}
+
+func NewMemoryTokenCache() *MemoryTokenCache {
+	return &MemoryTokenCache{tokens: map[string]*iamkey.CreateIamTokenResponse{}}
+}
+
+func (c *MemoryTokenCache) Get(ctx context.Context, key string) (*iamkey.CreateIamTokenResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	token, ok := c.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(token).(*iamkey.CreateIamTokenResponse), nil
+}
+
+func (c *MemoryTokenCache) Set(ctx context.Context, key string, token *iamkey.CreateIamTokenResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[key] = proto.Clone(token).(*iamkey.CreateIamTokenResponse)
+	return nil
+}
+
+func (c *MemoryTokenCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, key)
+	return nil
+}
+
+// DefaultKeyringService is a service name under which KeyringTokenCache stores tokens by default.
+const DefaultKeyringService = "doublecloud-go-sdk"
+
+// KeyringTokenCache stores tokens in OS keyring: macOS Keychain or Secret Service on Linux.
+type KeyringTokenCache struct {
+	service string
+}
+
+var _ TokenCache = &KeyringTokenCache{}
+
+// NewKeyringTokenCache creates KeyringTokenCache. DefaultKeyringService is used if service is empty.
+func NewKeyringTokenCache(service string) (*KeyringTokenCache, error) {
+	if !keyring.Supported() {
+		return nil, errors.New("OS keyring is not available")
+	}
+	if service == "" {
+		service = DefaultKeyringService
+	}
+	return &KeyringTokenCache{service: service}, nil
+}
+
+func (c *KeyringTokenCache) Get(ctx context.Context, key string) (*iamkey.CreateIamTokenResponse, error) {
+	data, err := keyring.Get(c.service, key)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "keyring read fail")
+	}
+	token := &iamkey.CreateIamTokenResponse{}
+	if err := json.Unmarshal([]byte(data), token); err != nil {
+		return nil, sdkerrors.WithMessage(err, "keyring token unmarshal fail")
+	}
+	return token, nil
+}
+
+func (c *KeyringTokenCache) Set(ctx context.Context, key string, token *iamkey.CreateIamTokenResponse) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return sdkerrors.WithMessage(err, "token marshal fail")
+	}
+	return sdkerrors.WithMessage(keyring.Set(c.service, key, string(data)), "keyring write fail")
+}
+
+func (c *KeyringTokenCache) Delete(ctx context.Context, key string) error {
+	return sdkerrors.WithMessage(keyring.Delete(c.service, key), "keyring delete fail")
+}
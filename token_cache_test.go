@@ -0,0 +1,89 @@
+package dcsdk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFileTokenCache(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "cache")
+	cache := NewFileTokenCache(dir)
+
+	token, err := cache.Get(ctx, "fed")
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	expiresAt := timestamppb.New(time.Now().Add(time.Hour).Truncate(time.Second))
+	require.NoError(t, cache.Set(ctx, "fed", &iamkey.CreateIamTokenResponse{IamToken: "token", ExpiresAt: expiresAt}))
+
+	token, err = cache.Get(ctx, "fed")
+	require.NoError(t, err)
+	assert.Equal(t, "token", token.IamToken)
+	assert.Equal(t, expiresAt.AsTime(), token.ExpiresAt.AsTime())
+
+	if runtime.GOOS != "windows" {
+		st, err := os.Stat(filepath.Join(dir, "fed.json"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), st.Mode().Perm())
+		st, err = os.Stat(dir)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0700), st.Mode().Perm())
+	}
+
+	require.NoError(t, cache.Delete(ctx, "fed"))
+	require.NoError(t, cache.Delete(ctx, "fed"))
+	token, err = cache.Get(ctx, "fed")
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	_, err = cache.Get(ctx, "../fed")
+	assert.Error(t, err)
+}
+
+func TestFileTokenCache_Lock(t *testing.T) {
+	cache := NewFileTokenCache(t.TempDir())
+	unlock, err := cache.Lock(context.Background(), "fed")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = cache.Lock(ctx, "fed")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, unlock())
+	unlock, err = cache.Lock(context.Background(), "fed")
+	require.NoError(t, err)
+	require.NoError(t, unlock())
+}
+
+func TestFederationCredentials_InvalidateIAMToken(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryTokenCache()
+	valid := &iamkey.CreateIamTokenResponse{IamToken: "token", ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))}
+	require.NoError(t, cache.Set(ctx, "fed", valid))
+
+	creds := NewFederationCredentials(&FederationConfig{FederationID: "fed", TokenCache: cache}).(*FederationCredentials)
+	token, err := creds.IAMToken(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "token", token.IamToken)
+
+	require.NoError(t, creds.InvalidateIAMToken(ctx, "other"))
+	cached, err := cache.Get(ctx, "fed")
+	require.NoError(t, err)
+	assert.NotNil(t, cached, "only the current token may be invalidated")
+
+	require.NoError(t, creds.InvalidateIAMToken(ctx, "token"))
+	cached, err = cache.Get(ctx, "fed")
+	require.NoError(t, err)
+	assert.Nil(t, cached)
+}
@@ -0,0 +1,280 @@
+package dcsdk
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/doublecloud/go-sdk/pkg/sdkerrors"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultTokenExchangeDialTimeout    = 5 * time.Second
+	DefaultTokenExchangeRequestTimeout = 30 * time.Second
+	DefaultTokenExchangeMaxRetries     = 3
+	DefaultTokenExchangeRetryBackoff   = 500 * time.Millisecond
+
+	jwtBearerGrantType     = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+	// JWTTokenType is a subject token type of OIDC ID tokens and other JWTs, see RFC 8693.
+	JWTTokenType = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// TokenExchanger exchanges IAM token requests built by ExchangeableCredentials, and external identity tokens
+// of SubjectTokenCredentials, for IAM tokens.
+type TokenExchanger interface {
+	Exchange(ctx context.Context, request *iamkey.CreateIamTokenRequest) (*iamkey.CreateIamTokenResponse, error)
+	// ExchangeSubjectToken exchanges external identity token with OAuth 2.0 Token Exchange (RFC 8693) grant.
+	ExchangeSubjectToken(ctx context.Context, request *SubjectTokenRequest) (*iamkey.CreateIamTokenResponse, error)
+}
+
+// HTTPTokenExchanger is a TokenExchanger that uses OAuth token endpoint of DoubleCloud.
+// Zero value is ready to use: all the fields are optional and defaults are applied on first use.
+type HTTPTokenExchanger struct {
+	// TokenURL is an OAuth token endpoint. By default DOUBLE_CLOUD_TOKEN_URL env or
+	// https://auth.double.cloud/oauth/token is used.
+	TokenURL string
+	// Client is used to make requests. When nil, client with DialTimeout and TLSConfig is created.
+	Client *http.Client
+	// TLSConfig is used to connect to token endpoint. Ignored if Client is set.
+	TLSConfig *tls.Config
+	// DialTimeout limits connection establishment. Ignored if Client is set.
+	DialTimeout time.Duration
+	// RequestTimeout limits every single attempt including reading the response body.
+	RequestTimeout time.Duration
+	// MaxRetries is a number of additional attempts on transient failures: network errors,
+	// 429 and 5xx responses. Negative value disables retries.
+	MaxRetries int
+	// RetryBackoff is a base delay between attempts, it doubles after each attempt.
+	RetryBackoff time.Duration
+	// UserAgent of token requests. SDK User-Agent is used by default.
+	UserAgent string
+}
+
+// SubjectTokenRequest is an OAuth 2.0 Token Exchange (RFC 8693) request of IAM token for external identity token.
+type SubjectTokenRequest struct {
+	SubjectToken     string
+	SubjectTokenType string
+	// Audience is optional target audience of the requested token.
+	Audience string
+}
+
+var _ TokenExchanger = &HTTPTokenExchanger{}
+
+// OAuthError is returned by HTTPTokenExchanger when token endpoint responds with non-200 status.
+// Code and Description are parsed from the OAuth error response body, when present.
+type OAuthError struct {
+	StatusCode  int
+	Status      string
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *OAuthError) Error() string {
+	msg := e.Status
+	if e.Code != "" {
+		msg += ": " + e.Code
+	}
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	if e.StatusCode == http.StatusNotFound {
+		msg += ".\nIs this IAM running using Service Account? That is, Instance.service_account_id should not be empty."
+	}
+	return msg
+}
+
+// Temporary reports whether the request may succeed if retried.
+func (e *OAuthError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// Exchange implements TokenExchanger.
+func (e *HTTPTokenExchanger) Exchange(ctx context.Context, request *iamkey.CreateIamTokenRequest) (*iamkey.CreateIamTokenResponse, error) {
+	data := url.Values{}
+	data.Set("grant_type", jwtBearerGrantType)
+	data.Set("assertion", request.GetJwt())
+	return e.exchange(ctx, data)
+}
+
+// ExchangeSubjectToken implements TokenExchanger.
+func (e *HTTPTokenExchanger) ExchangeSubjectToken(ctx context.Context, request *SubjectTokenRequest) (*iamkey.CreateIamTokenResponse, error) {
+	data := url.Values{}
+	data.Set("grant_type", tokenExchangeGrantType)
+	data.Set("subject_token", request.SubjectToken)
+	tokenType := request.SubjectTokenType
+	if tokenType == "" {
+		tokenType = JWTTokenType
+	}
+	data.Set("subject_token_type", tokenType)
+	if request.Audience != "" {
+		data.Set("audience", request.Audience)
+	}
+	return e.exchange(ctx, data)
+}
+
+func (e *HTTPTokenExchanger) exchange(ctx context.Context, data url.Values) (*iamkey.CreateIamTokenResponse, error) {
+	client := e.client()
+	var resp *iamkey.CreateIamTokenResponse
+	err := retryTemporary(ctx, e.MaxRetries, e.RetryBackoff, "IAM token exchange", func() error {
+		var err error
+		resp, err = e.exchangeOnce(ctx, client, data)
+		return err
+	})
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "failed to exchange token")
+	}
+	return resp, nil
+}
+
+// retryTemporary calls f until it succeeds, fails with non-temporary error or maxRetries retries are made.
+// Zero maxRetries and backoff mean defaults, negative maxRetries disables retries.
+func retryTemporary(ctx context.Context, maxRetries int, backoff time.Duration, op string, f func() error) error {
+	if maxRetries == 0 {
+		maxRetries = DefaultTokenExchangeMaxRetries
+	}
+	if backoff <= 0 {
+		backoff = DefaultTokenExchangeRetryBackoff
+	}
+	for attempt := 0; ; attempt++ {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if attempt >= maxRetries || !isTemporaryError(err) {
+			return err
+		}
+		grpclog.Warningf("%s attempt %d failed, retrying in %s: %v", op, attempt+1, backoff, err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return sdkerrors.WithMessage(err, ctx.Err().Error())
+		}
+		backoff *= 2
+	}
+}
+
+func (e *HTTPTokenExchanger) client() *http.Client {
+	if e.Client != nil {
+		return e.Client
+	}
+	dialTimeout := e.DialTimeout
+	if dialTimeout <= 0 {
+		dialTimeout = DefaultTokenExchangeDialTimeout
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: e.TLSConfig,
+			DialContext: (&net.Dialer{
+				Timeout:   dialTimeout,
+				KeepAlive: -1, // No keep alive. Near token per hour requested.
+			}).DialContext,
+		},
+	}
+}
+
+func (e *HTTPTokenExchanger) tokenURL() string {
+	if e.TokenURL != "" {
+		return e.TokenURL
+	}
+	return tokenURL()
+}
+
+func (e *HTTPTokenExchanger) exchangeOnce(ctx context.Context, client *http.Client, data url.Values) (*iamkey.CreateIamTokenResponse, error) {
+	requestTimeout := e.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = DefaultTokenExchangeRequestTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.tokenURL(), strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "request make failed")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ua := e.UserAgent
+	if ua == "" {
+		ua = userAgent("")
+	}
+	req.Header.Set("User-Agent", ua)
+	reqDump, _ := httputil.DumpRequestOut(req, false)
+	grpclog.Infof("Going to request IAM token:\n%s", reqDump)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respDump, _ := httputil.DumpResponse(resp, false)
+	grpclog.Infof("response (without body, because contains sensitive token):\n%s", respDump)
+
+	body, err := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		oauthErr := &OAuthError{StatusCode: resp.StatusCode, Status: resp.Status}
+		if err != nil {
+			body = []byte(fmt.Sprintf("Failed response body read failed: %s", err.Error()))
+		} else {
+			_ = json.Unmarshal(body, oauthErr)
+		}
+		grpclog.Errorf("IAM service token get failed: %s. Body:\n%s", resp.Status, body)
+		return nil, oauthErr
+	}
+	if err != nil {
+		return nil, sdkerrors.WithMessage(err, "response read failed")
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}
+	err = json.Unmarshal(body, &tokenResponse)
+	if err != nil {
+		grpclog.Errorf("Failed to unmarshal SA token response body.\nError: %s", err)
+		return nil, sdkerrors.WithMessage(err, "body unmarshal failed")
+	}
+	expiresAt := timestamppb.Now()
+	expiresAt.Seconds += tokenResponse.ExpiresIn - 1
+	expiresAt.Nanos = 0 // Truncate is for readability.
+	return &iamkey.CreateIamTokenResponse{
+		IamToken:  tokenResponse.AccessToken,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// isTemporaryError reports whether the request may succeed if retried: 5xx and 429 responses, timeouts,
+// reset and refused connections and responses cut short. TLS, DNS and URL errors are not temporary.
+func isTemporaryError(err error) bool {
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && !errors.As(err, new(net.Error)) {
+		return temporary.Temporary()
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
@@ -0,0 +1,137 @@
+package dcsdk
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/doublecloud/go-sdk/iamkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func jwtRequest(jwt string) *iamkey.CreateIamTokenRequest {
+	return &iamkey.CreateIamTokenRequest{Identity: &iamkey.CreateIamTokenRequest_Jwt{Jwt: jwt}}
+}
+
+func TestHTTPTokenExchanger_Ok(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, jwtBearerGrantType, r.PostForm.Get("grant_type"))
+		assert.Equal(t, "signed.jwt.value", r.PostForm.Get("assertion"))
+		assert.Equal(t, "doublecloud-go-sdk/"+Version+" my-tool/1.0", r.UserAgent())
+		_, _ = w.Write([]byte(`{"access_token":"iam-token","expires_in":3600,"token_type":"Bearer"}`))
+	}))
+	defer srv.Close()
+
+	e := &HTTPTokenExchanger{TokenURL: srv.URL, UserAgent: userAgent("my-tool/1.0")}
+	resp, err := e.Exchange(context.Background(), jwtRequest("signed.jwt.value"))
+	require.NoError(t, err)
+	assert.Equal(t, "iam-token", resp.IamToken)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), resp.ExpiresAt.AsTime(), 5*time.Second)
+}
+
+func TestHTTPTokenExchanger_RetryOnServerError(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"iam-token","expires_in":3600}`))
+	}))
+	defer srv.Close()
+
+	e := &HTTPTokenExchanger{TokenURL: srv.URL, RetryBackoff: time.Millisecond}
+	resp, err := e.Exchange(context.Background(), jwtRequest("jwt"))
+	require.NoError(t, err)
+	assert.Equal(t, "iam-token", resp.IamToken)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls))
+}
+
+func TestHTTPTokenExchanger_OAuthError(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"JWT signature is invalid"}`))
+	}))
+	defer srv.Close()
+
+	e := &HTTPTokenExchanger{TokenURL: srv.URL, RetryBackoff: time.Millisecond}
+	_, err := e.Exchange(context.Background(), jwtRequest("jwt"))
+	require.Error(t, err)
+
+	var oauthErr *OAuthError
+	require.True(t, errors.As(err, &oauthErr))
+	assert.Equal(t, http.StatusBadRequest, oauthErr.StatusCode)
+	assert.Equal(t, "invalid_grant", oauthErr.Code)
+	assert.Equal(t, "JWT signature is invalid", oauthErr.Description)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls), "client errors must not be retried")
+}
+
+func TestHTTPTokenExchanger_RequestTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	e := &HTTPTokenExchanger{TokenURL: srv.URL, RequestTimeout: 10 * time.Millisecond, MaxRetries: -1}
+	_, err := e.Exchange(context.Background(), jwtRequest("jwt"))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestHTTPTokenExchanger_NoRetryOnUntrustedCA(t *testing.T) {
+	var conns int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not pass TLS handshake")
+	}))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	e := &HTTPTokenExchanger{TokenURL: srv.URL, RetryBackoff: time.Millisecond}
+	_, err := e.Exchange(context.Background(), jwtRequest("jwt"))
+	var unknownAuthority x509.UnknownAuthorityError
+	assert.ErrorAs(t, err, &unknownAuthority)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&conns), "TLS error must not be retried")
+}
+
+func TestIsTemporaryError(t *testing.T) {
+	urlErr := func(err error) error {
+		return &url.Error{Op: "Post", URL: "https://auth.double.cloud/oauth/token", Err: err}
+	}
+	for err, temporary := range map[error]bool{
+		urlErr(&net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}): true,
+		urlErr(&net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}):      true,
+		urlErr(io.ErrUnexpectedEOF):                                                             true,
+		urlErr(context.DeadlineExceeded):                                                        true,
+		&OAuthError{StatusCode: http.StatusBadGateway}:                                          true,
+		&OAuthError{StatusCode: http.StatusBadRequest}:                                          false,
+		urlErr(&net.DNSError{Err: "no such host", Name: "auth.double.cloud", IsNotFound: true}): false,
+		urlErr(errors.New(`unsupported protocol scheme "ftp"`)):                                 false,
+		urlErr(context.Canceled):                                                                false,
+	} {
+		assert.Equal(t, temporary, isTemporaryError(err), err.Error())
+	}
+}
@@ -0,0 +1,76 @@
+package dcsdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// TokenInfo describes IAM token without revealing the token itself.
+type TokenInfo struct {
+	// JWT is true if the token is JWT and the claims below were parsed from it.
+	// Signature is not verified, so the claims must be used for debugging and auditing only.
+	JWT      bool
+	Subject  string
+	Issuer   string
+	Audience []string
+	IssuedAt time.Time
+	// ExpiresAt is the expiration time reported by the token issuer, or the JWT exp claim if the issuer did not report it.
+	ExpiresAt time.Time
+	// Claims are all claims of JWT, e.g. custom organization claims.
+	Claims map[string]interface{}
+	// CredentialsType is Go type of Credentials the token was issued for, e.g. "*dcsdk.ExecCredentials".
+	CredentialsType string
+	// ServiceAccountID is set for tokens issued for delegated service account, see WithAuthAsServiceAccount.
+	ServiceAccountID string
+}
+
+// TokenIssuedHook is called by IamTokenMiddleware every time it issues or refreshes a token.
+type TokenIssuedHook func(ctx context.Context, info TokenInfo)
+
+// ParseTokenInfo parses claims of iamToken if it is JWT. Non JWT tokens are described by zero TokenInfo.
+func ParseTokenInfo(iamToken string) TokenInfo {
+	var info TokenInfo
+	parser := jwt.NewParser()
+	var claims jwt.RegisteredClaims
+	if _, _, err := parser.ParseUnverified(iamToken, &claims); err != nil {
+		return info
+	}
+	mapClaims := jwt.MapClaims{}
+	if _, _, err := parser.ParseUnverified(iamToken, mapClaims); err != nil {
+		return info
+	}
+	info.JWT = true
+	info.Subject = claims.Subject
+	info.Issuer = claims.Issuer
+	info.Audience = claims.Audience
+	if claims.IssuedAt != nil {
+		info.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		info.ExpiresAt = claims.ExpiresAt.Time
+	}
+	info.Claims = mapClaims
+	return info
+}
+
+func newTokenInfo(iamToken string, expiresAt time.Time, subject authSubject, a Authenticator) TokenInfo {
+	info := ParseTokenInfo(iamToken)
+	if !expiresAt.IsZero() {
+		info.ExpiresAt = expiresAt
+	}
+	switch subject := subject.(type) {
+	case credentialsSubject:
+		info.CredentialsType = fmt.Sprintf("%T", subject.creds)
+	case serviceAccountSubject:
+		info.ServiceAccountID = subject.serviceAccountID
+	}
+	if info.CredentialsType == "" {
+		if holder, ok := a.(interface{ credentials() Credentials }); ok {
+			info.CredentialsType = fmt.Sprintf("%T", holder.credentials())
+		}
+	}
+	return info
+}
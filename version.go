@@ -0,0 +1,51 @@
+package dcsdk
+
+import (
+	"runtime/debug"
+	"strings"
+)
+
+const (
+	modulePath       = "github.com/doublecloud/go-sdk"
+	userAgentProduct = "doublecloud-go-sdk"
+)
+
+// Version is a version of the SDK reported in User-Agent.
+// It is detected from the build info of the binary and can be set explicitly at build time with
+// -ldflags "-X github.com/doublecloud/go-sdk.Version=v1.2.3".
+var Version = ""
+
+func init() {
+	if Version == "" {
+		Version = moduleVersion()
+	}
+}
+
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	if info.Main.Path == modulePath && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != modulePath {
+			continue
+		}
+		if dep.Replace != nil && dep.Replace.Version != "" {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "devel"
+}
+
+// userAgent returns User-Agent of the SDK with applicationName appended, if it is set.
+func userAgent(applicationName string) string {
+	ua := userAgentProduct + "/" + Version
+	if applicationName = strings.TrimSpace(applicationName); applicationName != "" {
+		ua += " " + applicationName
+	}
+	return ua
+}